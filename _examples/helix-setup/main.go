@@ -4,10 +4,12 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"log/slog"
 
 	"github.com/kvizyx/twitchkit/api/helix"
 	"github.com/kvizyx/twitchkit/api/oauth"
 	"github.com/kvizyx/twitchkit/auth-provider"
+	"github.com/kvizyx/twitchkit/http-core"
 )
 
 func main() {
@@ -31,6 +33,10 @@ func main() {
 	client, err := helix.NewClient(helix.ClientConfig{
 		AuthProvider: authProvider,
 		RetryConfig:  helix.DefaultRetryConfig,
+		Middlewares: []httpcore.Middleware{
+			httpcore.LoggingMiddleware(slog.Default()),
+			httpcore.RequestIDMiddleware("", nil),
+		},
 	})
 	if err != nil {
 		log.Fatalf("failed to create helix client: %s", err)
@@ -0,0 +1,89 @@
+package extensions
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/kvizyx/twitchkit/api"
+)
+
+type (
+	ProductCost struct {
+		Amount int `json:"amount"`
+
+		// Type is a type of the currency, which is always "bits" (and set so if empty).
+		Type string `json:"type"`
+	}
+
+	BitsProduct struct {
+		SKU           string      `json:"sku"`
+		Cost          ProductCost `json:"cost"`
+		InDevelopment bool        `json:"in_development"`
+		DisplayName   string      `json:"display_name"`
+
+		// Expiration is a RFC3339 time when the product expires, it's empty if the
+		// product never expires.
+		Expiration string `json:"expiration,omitempty"`
+
+		// IsBroadcast is true if transactions of the product are broadcast to all
+		// instances of the extension on the channel.
+		IsBroadcast bool `json:"is_broadcast"`
+	}
+
+	BitsProductsOutput struct {
+		Products         []BitsProduct `json:"data"`
+		ResponseMetadata api.ResponseMetadata
+	}
+)
+
+// GetBitsProducts gets the list of Bits products of the extension. Expired and
+// disabled products are returned only if includeAll is true.
+//
+// Reference: https://dev.twitch.tv/docs/api/reference/#get-extension-bits-products
+//
+// Requires an app access token of the extension.
+func (c Client) GetBitsProducts(ctx context.Context, includeAll bool) (BitsProductsOutput, error) {
+	const resource = "bits/extensions"
+
+	values := url.Values{}
+	if includeAll {
+		values.Set("should_include_all", "true")
+	}
+
+	var output BitsProductsOutput
+
+	metadata, err := c.doHelixRequest(ctx, http.MethodGet, resource, values, nil, &output)
+	output.ResponseMetadata = metadata
+
+	if err != nil {
+		return output, err
+	}
+
+	return output, nil
+}
+
+// UpdateBitsProduct adds or updates a Bits product of the extension, identified by its
+// SKU.
+//
+// Reference: https://dev.twitch.tv/docs/api/reference/#update-extension-bits-product
+//
+// Requires an app access token of the extension.
+func (c Client) UpdateBitsProduct(ctx context.Context, product BitsProduct) (BitsProductsOutput, error) {
+	const resource = "bits/extensions"
+
+	if len(product.Cost.Type) == 0 {
+		product.Cost.Type = "bits"
+	}
+
+	var output BitsProductsOutput
+
+	metadata, err := c.doHelixRequest(ctx, http.MethodPut, resource, nil, product, &output)
+	output.ResponseMetadata = metadata
+
+	if err != nil {
+		return output, err
+	}
+
+	return output, nil
+}
@@ -0,0 +1,46 @@
+package extensions
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/kvizyx/twitchkit/api"
+	"github.com/kvizyx/twitchkit/http-core"
+)
+
+type SendChatMessageInput struct {
+	BroadcasterID string `json:"-"`
+
+	// Text is limited to 280 characters.
+	Text             string `json:"text"`
+	ExtensionVersion string `json:"extension_version"`
+}
+
+// SendChatMessage sends a message to the chat of the broadcaster that has the extension
+// activated. Messages are limited to 12 per minute per channel.
+//
+// Reference: https://dev.twitch.tv/docs/api/reference/#send-extension-chat-message
+//
+// Requires a JWT signed by the extension secret.
+func (c Client) SendChatMessage(ctx context.Context, input SendChatMessageInput) (api.ResponseMetadata, error) {
+	const resource = "extensions/chat"
+
+	values := url.Values{}
+	values.Set("broadcaster_id", input.BroadcasterID)
+
+	body := struct {
+		ExtensionID string `json:"extension_id"`
+		SendChatMessageInput
+	}{
+		ExtensionID:          c.extensionID,
+		SendChatMessageInput: input,
+	}
+
+	return c.doSignedRequest(ctx, httpcore.RequestOptions{
+		Resource:  resource,
+		Method:    http.MethodPost,
+		URLValues: values,
+		Body:      body,
+	}, c.externalClaims(), nil)
+}
@@ -0,0 +1,194 @@
+package extensions
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/kvizyx/twitchkit/api"
+	"github.com/kvizyx/twitchkit/api/helix"
+	"github.com/kvizyx/twitchkit/http-core"
+)
+
+// jwtTTL is a lifetime of the JWTs signed for requests to Twitch API.
+const jwtTTL = 3 * time.Minute
+
+// ErrNoHelixClient is returned by endpoints that require app access token of the
+// extension if Client has no Helix client to make requests with.
+var ErrNoHelixClient = errors.New("helix client is required for this endpoint")
+
+type (
+	// Client calls Twitch API on behalf of the extension backend (EBS). Most endpoints are
+	// authorized with JWTs signed by the extension secret, and the rest of them are made
+	// through Helix client with app access token of the extension.
+	Client struct {
+		extensionID string
+		ownerID     string
+		key         *signingKey
+		httpClient  httpcore.HTTPClient
+		helix       *helix.Client
+	}
+
+	ClientConfig struct {
+		// ExtensionID is a client ID of the extension.
+		ExtensionID string
+
+		// Secret is a base64 shared secret of the extension. It can be replaced later
+		// with Client.SetSecret when secrets are rotated.
+		Secret string
+
+		// OwnerID is a user ID of the extension owner. It's set as user_id of the JWTs
+		// signed for requests.
+		OwnerID    string
+		HTTPClient httpcore.HTTPClient
+
+		// Middlewares are wrapped around HTTPClient in the given order.
+		Middlewares []httpcore.Middleware
+
+		// Helix is used for endpoints that require app access token of the extension
+		// (GetLiveChannels and bits products endpoints), so its auth provider must use
+		// the extension client ID. These endpoints return ErrNoHelixClient if it's nil.
+		Helix *helix.Client
+	}
+)
+
+func NewClient(cfg ClientConfig) (*Client, error) {
+	if len(cfg.ExtensionID) == 0 {
+		return nil, errors.New("extension ID should not be empty")
+	}
+
+	if len(cfg.OwnerID) == 0 {
+		return nil, errors.New("owner ID should not be empty")
+	}
+
+	key, err := DecodeSecret(cfg.Secret)
+	if err != nil {
+		return nil, err
+	}
+
+	if cfg.HTTPClient == nil {
+		cfg.HTTPClient = httpcore.DefaultHTTPClient()
+	}
+
+	return &Client{
+		extensionID: cfg.ExtensionID,
+		ownerID:     cfg.OwnerID,
+		key:         &signingKey{key: key},
+		httpClient:  httpcore.Chain(cfg.HTTPClient, cfg.Middlewares...),
+		helix:       cfg.Helix,
+	}, nil
+}
+
+// ExtensionID returns client ID of the extension.
+func (c Client) ExtensionID() string {
+	return c.extensionID
+}
+
+// signingKey is a decoded secret that the client signs JWTs with. It's shared by the
+// copies of Client, so the secret replaced in one of them is used by all of them.
+type signingKey struct {
+	key    []byte
+	locker sync.RWMutex
+}
+
+// SetSecret replaces base64 secret that JWTs for requests are signed with. Twitch
+// accepts JWTs signed by any active secret, so it should be called once the secret
+// created by CreateSecret becomes active and before the current one expires. It's safe
+// to call it concurrently with requests.
+func (c Client) SetSecret(secret string) error {
+	key, err := DecodeSecret(secret)
+	if err != nil {
+		return err
+	}
+
+	c.key.locker.Lock()
+	c.key.key = key
+	c.key.locker.Unlock()
+
+	return nil
+}
+
+// UseNewestSecret signs JWTs for requests with the secret of the given ones that became
+// active last (e.g. from GetSecrets). It returns ErrNoKeys if none of them is active.
+func (c Client) UseNewestSecret(secrets Secrets) error {
+	var newest *Secret
+
+	now := time.Now()
+
+	for i, secret := range secrets.Secrets {
+		if secret.IsActive(now) && (newest == nil || secret.ActiveAt.After(newest.ActiveAt)) {
+			newest = &secrets.Secrets[i]
+		}
+	}
+
+	if newest == nil {
+		return ErrNoKeys
+	}
+
+	return c.SetSecret(newest.Content)
+}
+
+// currentKey returns the current key that JWTs are signed with.
+func (c Client) currentKey() []byte {
+	c.key.locker.RLock()
+	defer c.key.locker.RUnlock()
+
+	return c.key.key
+}
+
+// externalClaims returns claims of the JWT for requests to Twitch API.
+func (c Client) externalClaims() Claims {
+	return Claims{
+		ExpiresAt: time.Now().Add(jwtTTL).Unix(),
+		UserID:    c.ownerID,
+		Role:      RoleExternal,
+	}
+}
+
+// doSignedRequest makes request authorized with JWT signed for the given claims. Unlike
+// extension frontend requests to EBS, Twitch API expects the signed JWT with Bearer
+// scheme (see Extensions reference), so api.AuthTypeExtension is not used here.
+func (c Client) doSignedRequest(
+	ctx context.Context,
+	opts httpcore.RequestOptions,
+	claims Claims,
+	dest any,
+) (api.ResponseMetadata, error) {
+	token, err := Sign(claims, c.currentKey())
+	if err != nil {
+		return api.ResponseMetadata{}, err
+	}
+
+	opts.APIType = api.TypeHelix
+
+	req, err := httpcore.NewAPIRequest(ctx, opts, true)
+	if err != nil {
+		return api.ResponseMetadata{}, err
+	}
+
+	api.SetClientHeader(req, c.extensionID)
+	api.SetAuthHeader(req, api.AuthTypeBearer, token)
+
+	return httpcore.DoAPIRequest(req, dest, c.httpClient)
+}
+
+// doHelixRequest makes request through Helix client with its access token selection.
+func (c Client) doHelixRequest(
+	ctx context.Context,
+	method, resource string,
+	query url.Values,
+	body, dest any,
+) (api.ResponseMetadata, error) {
+	if c.helix == nil {
+		return api.ResponseMetadata{}, ErrNoHelixClient
+	}
+
+	return helix.DoRaw(ctx, *c.helix, helix.RawRequest{
+		Method:   method,
+		Resource: resource,
+		Query:    query,
+		Body:     body,
+	}, dest)
+}
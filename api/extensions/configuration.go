@@ -0,0 +1,156 @@
+package extensions
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/kvizyx/twitchkit/api"
+	"github.com/kvizyx/twitchkit/http-core"
+)
+
+// Segment is a configuration segment of the extension.
+type Segment string
+
+const (
+	SegmentBroadcaster Segment = "broadcaster"
+	SegmentDeveloper   Segment = "developer"
+	SegmentGlobal      Segment = "global"
+)
+
+type (
+	ConfigurationSegment struct {
+		Segment Segment `json:"segment"`
+
+		// BroadcasterID is empty for the global segment.
+		BroadcasterID string `json:"broadcaster_id"`
+		Content       string `json:"content"`
+		Version       string `json:"version"`
+	}
+
+	GetConfigurationSegmentInput struct {
+		// BroadcasterID is required for the broadcaster and developer segments.
+		BroadcasterID string
+		Segments      []Segment
+	}
+
+	GetConfigurationSegmentOutput struct {
+		Segments         []ConfigurationSegment `json:"data"`
+		ResponseMetadata api.ResponseMetadata
+	}
+)
+
+// GetConfigurationSegment gets the specified configuration segments of the extension.
+//
+// Reference: https://dev.twitch.tv/docs/api/reference/#get-extension-configuration-segment
+//
+// Requires a JWT signed by the extension secret.
+func (c Client) GetConfigurationSegment(
+	ctx context.Context,
+	input GetConfigurationSegmentInput,
+) (GetConfigurationSegmentOutput, error) {
+	const resource = "extensions/configurations"
+
+	values := url.Values{}
+	values.Set("extension_id", c.extensionID)
+
+	if len(input.BroadcasterID) != 0 {
+		values.Set("broadcaster_id", input.BroadcasterID)
+	}
+
+	for _, segment := range input.Segments {
+		values.Add("segment", string(segment))
+	}
+
+	var output GetConfigurationSegmentOutput
+
+	metadata, err := c.doSignedRequest(ctx, httpcore.RequestOptions{
+		Resource:  resource,
+		Method:    http.MethodGet,
+		URLValues: values,
+	}, c.externalClaims(), &output)
+	output.ResponseMetadata = metadata
+
+	if err != nil {
+		return output, err
+	}
+
+	return output, nil
+}
+
+type SetConfigurationSegmentInput struct {
+	Segment Segment `json:"segment"`
+
+	// BroadcasterID is required for the broadcaster and developer segments.
+	BroadcasterID string `json:"broadcaster_id,omitempty"`
+	Content       string `json:"content,omitempty"`
+	Version       string `json:"version,omitempty"`
+}
+
+// SetConfigurationSegment updates a configuration segment of the extension. The content
+// of the segment is limited to 5 KB.
+//
+// Reference: https://dev.twitch.tv/docs/api/reference/#set-extension-configuration-segment
+//
+// Requires a JWT signed by the extension secret.
+func (c Client) SetConfigurationSegment(
+	ctx context.Context,
+	input SetConfigurationSegmentInput,
+) (api.ResponseMetadata, error) {
+	const resource = "extensions/configurations"
+
+	body := struct {
+		ExtensionID string `json:"extension_id"`
+		SetConfigurationSegmentInput
+	}{
+		ExtensionID:                  c.extensionID,
+		SetConfigurationSegmentInput: input,
+	}
+
+	return c.doSignedRequest(ctx, httpcore.RequestOptions{
+		Resource: resource,
+		Method:   http.MethodPut,
+		Body:     body,
+	}, c.externalClaims(), nil)
+}
+
+type SetRequiredConfigurationInput struct {
+	BroadcasterID    string `json:"-"`
+	ExtensionVersion string `json:"extension_version"`
+
+	// RequiredConfiguration must match the value set in the developer console, so the
+	// extension is activatable.
+	RequiredConfiguration string `json:"required_configuration"`
+}
+
+// SetRequiredConfiguration updates the extension’s required_configuration string for
+// the broadcaster. Use it if the extension requires the broadcaster to configure it
+// before activating it.
+//
+// Reference: https://dev.twitch.tv/docs/api/reference/#set-extension-required-configuration
+//
+// Requires a JWT signed by the extension secret.
+func (c Client) SetRequiredConfiguration(
+	ctx context.Context,
+	input SetRequiredConfigurationInput,
+) (api.ResponseMetadata, error) {
+	const resource = "extensions/required_configuration"
+
+	values := url.Values{}
+	values.Set("broadcaster_id", input.BroadcasterID)
+
+	body := struct {
+		ExtensionID string `json:"extension_id"`
+		SetRequiredConfigurationInput
+	}{
+		ExtensionID:                   c.extensionID,
+		SetRequiredConfigurationInput: input,
+	}
+
+	return c.doSignedRequest(ctx, httpcore.RequestOptions{
+		Resource:  resource,
+		Method:    http.MethodPut,
+		URLValues: values,
+		Body:      body,
+	}, c.externalClaims(), nil)
+}
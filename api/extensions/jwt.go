@@ -0,0 +1,186 @@
+package extensions
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/kvizyx/twitchkit/api"
+)
+
+var (
+	ErrInvalidSecret    = errors.New("invalid extension secret")
+	ErrInvalidToken     = errors.New("invalid extension JWT")
+	ErrInvalidSignature = errors.New("extension JWT signature does not match any secret")
+	ErrTokenExpired     = errors.New("extension JWT is expired")
+)
+
+// Role is a role of the user that the extension JWT is issued for.
+type Role string
+
+const (
+	// RoleExternal is a role of the JWT signed by the extension backend (EBS) to call
+	// Twitch API.
+	RoleExternal    Role = "external"
+	RoleBroadcaster Role = "broadcaster"
+	RoleModerator   Role = "moderator"
+	RoleViewer      Role = "viewer"
+)
+
+// jwtHeader is the only header that extension JWTs are signed with.
+var jwtHeader = base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"HS256","typ":"JWT"}`))
+
+// PubSubPerms defines Extension PubSub targets that the JWT holder can listen and
+// send messages to.
+type PubSubPerms struct {
+	Listen []string `json:"listen,omitempty"`
+	Send   []string `json:"send,omitempty"`
+}
+
+// Claims are claims of the extension JWT.
+type Claims struct {
+	// ExpiresAt is a unix time when the token expires.
+	ExpiresAt int64 `json:"exp"`
+
+	// OpaqueUserID is an identifier of the viewer, which is stable for the logged-in
+	// viewers (starts with "U") and changes for anonymous ones (starts with "A").
+	OpaqueUserID string `json:"opaque_user_id,omitempty"`
+
+	// UserID is a Twitch user ID of the viewer, which is set only if the viewer shared
+	// their identity with the extension. For RoleExternal, it's ID of the extension
+	// owner.
+	UserID    string `json:"user_id,omitempty"`
+	ChannelID string `json:"channel_id,omitempty"`
+	Role      Role   `json:"role"`
+
+	// IsUnlinked is true if the viewer didn't share their identity with the extension.
+	IsUnlinked  bool         `json:"is_unlinked,omitempty"`
+	PubSubPerms *PubSubPerms `json:"pubsub_perms,omitempty"`
+}
+
+// Expiration returns time when the token expires.
+func (c Claims) Expiration() time.Time {
+	return time.Unix(c.ExpiresAt, 0)
+}
+
+// DecodeSecret decodes base64 extension secret (as it's shown in the developer console
+// and returned by Client.GetExtensionSecrets) into the key for signing and verifying
+// extension JWTs.
+func DecodeSecret(secret string) ([]byte, error) {
+	key, err := base64.StdEncoding.DecodeString(secret)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidSecret, err)
+	}
+
+	if len(key) == 0 {
+		return nil, ErrInvalidSecret
+	}
+
+	return key, nil
+}
+
+// Sign signs claims with the decoded extension secret using HS256.
+func Sign(claims Claims, key []byte) (string, error) {
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		return "", fmt.Errorf("marshal claims: %w", err)
+	}
+
+	unsigned := jwtHeader + "." + base64.RawURLEncoding.EncodeToString(payload)
+
+	return unsigned + "." + base64.RawURLEncoding.EncodeToString(signature(unsigned, key)), nil
+}
+
+// Verify checks that the token is signed with one of the decoded extension secrets
+// (there are a few of them during secret rotation) and is not expired, and returns
+// its claims. Only HS256 tokens are accepted.
+func Verify(token string, keys ...[]byte) (Claims, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return Claims{}, fmt.Errorf("%w: malformed token", ErrInvalidToken)
+	}
+
+	headerJSON, err := base64.RawURLEncoding.DecodeString(parts[0])
+	if err != nil {
+		return Claims{}, fmt.Errorf("%w: decode header: %w", ErrInvalidToken, err)
+	}
+
+	var header struct {
+		Alg string `json:"alg"`
+	}
+
+	if err = json.Unmarshal(headerJSON, &header); err != nil {
+		return Claims{}, fmt.Errorf("%w: unmarshal header: %w", ErrInvalidToken, err)
+	}
+
+	// the algorithm is never taken from the token, so "none" and alike are rejected.
+	if header.Alg != "HS256" {
+		return Claims{}, fmt.Errorf("%w: unexpected algorithm %q", ErrInvalidToken, header.Alg)
+	}
+
+	tokenSignature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return Claims{}, fmt.Errorf("%w: decode signature: %w", ErrInvalidToken, err)
+	}
+
+	unsigned := parts[0] + "." + parts[1]
+
+	verified := false
+	for _, key := range keys {
+		if len(key) != 0 && hmac.Equal(tokenSignature, signature(unsigned, key)) {
+			verified = true
+			break
+		}
+	}
+
+	if !verified {
+		return Claims{}, ErrInvalidSignature
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return Claims{}, fmt.Errorf("%w: decode payload: %w", ErrInvalidToken, err)
+	}
+
+	var claims Claims
+	if err = json.Unmarshal(payload, &claims); err != nil {
+		return Claims{}, fmt.Errorf("%w: unmarshal claims: %w", ErrInvalidToken, err)
+	}
+
+	if claims.ExpiresAt == 0 || !time.Now().Before(claims.Expiration()) {
+		return Claims{}, ErrTokenExpired
+	}
+
+	return claims, nil
+}
+
+// TokenFromHeader returns extension JWT from the Authorization header. Both Bearer and
+// Extension (api.AuthTypeExtension) schemes are accepted.
+func TokenFromHeader(header http.Header) (string, bool) {
+	scheme, token, ok := strings.Cut(header.Get("Authorization"), " ")
+	if !ok {
+		return "", false
+	}
+
+	if !strings.EqualFold(scheme, string(api.AuthTypeBearer)) &&
+		!strings.EqualFold(scheme, string(api.AuthTypeExtension)) {
+		return "", false
+	}
+
+	token = strings.TrimSpace(token)
+
+	return token, len(token) != 0
+}
+
+func signature(unsigned string, key []byte) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(unsigned))
+
+	return mac.Sum(nil)
+}
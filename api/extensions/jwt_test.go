@@ -0,0 +1,215 @@
+package extensions
+
+import (
+	"encoding/base64"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+var (
+	testKey      = []byte("extension-secret")
+	testOtherKey = []byte("another-secret")
+)
+
+func testClaims() Claims {
+	return Claims{
+		ExpiresAt:    time.Now().Add(time.Minute).Unix(),
+		OpaqueUserID: "U1",
+		UserID:       "1",
+		ChannelID:    "2",
+		Role:         RoleViewer,
+		PubSubPerms:  &PubSubPerms{Listen: []string{"broadcast"}},
+	}
+}
+
+// unsignedToken returns token with the given header and claims, and signature of key.
+func unsignedToken(t *testing.T, header string, claims string, key []byte) string {
+	t.Helper()
+
+	unsigned := base64.RawURLEncoding.EncodeToString([]byte(header)) + "." +
+		base64.RawURLEncoding.EncodeToString([]byte(claims))
+
+	return unsigned + "." + base64.RawURLEncoding.EncodeToString(signature(unsigned, key))
+}
+
+func TestSignVerifyRoundTrip(t *testing.T) {
+	claims := testClaims()
+
+	token, err := Sign(claims, testKey)
+	if err != nil {
+		t.Fatalf("sign: %v", err)
+	}
+
+	verified, err := Verify(token, testOtherKey, testKey)
+	if err != nil {
+		t.Fatalf("verify: %v", err)
+	}
+
+	if verified.ExpiresAt != claims.ExpiresAt ||
+		verified.OpaqueUserID != claims.OpaqueUserID ||
+		verified.UserID != claims.UserID ||
+		verified.ChannelID != claims.ChannelID ||
+		verified.Role != claims.Role {
+		t.Errorf("claims = %+v, want %+v", verified, claims)
+	}
+
+	if verified.PubSubPerms == nil || len(verified.PubSubPerms.Listen) != 1 {
+		t.Errorf("pubsub perms = %+v, want %+v", verified.PubSubPerms, claims.PubSubPerms)
+	}
+}
+
+func TestVerify(t *testing.T) {
+	valid, err := Sign(testClaims(), testKey)
+	if err != nil {
+		t.Fatalf("sign: %v", err)
+	}
+
+	expiredClaims := testClaims()
+	expiredClaims.ExpiresAt = time.Now().Add(-time.Minute).Unix()
+
+	expired, err := Sign(expiredClaims, testKey)
+	if err != nil {
+		t.Fatalf("sign: %v", err)
+	}
+
+	parts := strings.Split(valid, ".")
+
+	tests := []struct {
+		name    string
+		token   string
+		keys    [][]byte
+		wantErr error
+	}{
+		{
+			name:    "wrong key",
+			token:   valid,
+			keys:    [][]byte{testOtherKey},
+			wantErr: ErrInvalidSignature,
+		},
+		{
+			name:    "no keys",
+			token:   valid,
+			wantErr: ErrInvalidSignature,
+		},
+		{
+			name:    "expired",
+			token:   expired,
+			keys:    [][]byte{testKey},
+			wantErr: ErrTokenExpired,
+		},
+		{
+			name:    "missing exp",
+			token:   unsignedToken(t, `{"alg":"HS256","typ":"JWT"}`, `{"role":"viewer"}`, testKey),
+			keys:    [][]byte{testKey},
+			wantErr: ErrTokenExpired,
+		},
+		{
+			name:    "alg none",
+			token:   unsignedToken(t, `{"alg":"none","typ":"JWT"}`, `{"role":"viewer"}`, testKey),
+			keys:    [][]byte{testKey},
+			wantErr: ErrInvalidToken,
+		},
+		{
+			name:    "wrong alg",
+			token:   unsignedToken(t, `{"alg":"HS512","typ":"JWT"}`, `{"role":"viewer"}`, testKey),
+			keys:    [][]byte{testKey},
+			wantErr: ErrInvalidToken,
+		},
+		{
+			name:    "tampered claims",
+			token:   parts[0] + "." + base64.RawURLEncoding.EncodeToString([]byte(`{"role":"broadcaster"}`)) + "." + parts[2],
+			keys:    [][]byte{testKey},
+			wantErr: ErrInvalidSignature,
+		},
+		{
+			name:    "malformed",
+			token:   parts[0] + "." + parts[1],
+			keys:    [][]byte{testKey},
+			wantErr: ErrInvalidToken,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Verify(tt.token, tt.keys...)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("err = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestClientSetSecret(t *testing.T) {
+	client, err := NewClient(ClientConfig{
+		ExtensionID: "extension",
+		OwnerID:     "1",
+		Secret:      base64.StdEncoding.EncodeToString(testKey),
+	})
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	// copy of the client must see the replaced secret too.
+	copied := *client
+
+	if err = client.SetSecret(base64.StdEncoding.EncodeToString(testOtherKey)); err != nil {
+		t.Fatalf("set secret: %v", err)
+	}
+
+	token, err := Sign(copied.externalClaims(), copied.currentKey())
+	if err != nil {
+		t.Fatalf("sign: %v", err)
+	}
+
+	if _, err = Verify(token, testOtherKey); err != nil {
+		t.Errorf("verify with the new secret: %v", err)
+	}
+
+	if err = client.SetSecret("not base64!"); !errors.Is(err, ErrInvalidSecret) {
+		t.Errorf("err = %v, want %v", err, ErrInvalidSecret)
+	}
+}
+
+func TestClientUseNewestSecret(t *testing.T) {
+	client, err := NewClient(ClientConfig{
+		ExtensionID: "extension",
+		OwnerID:     "1",
+		Secret:      base64.StdEncoding.EncodeToString(testKey),
+	})
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	now := time.Now()
+
+	err = client.UseNewestSecret(Secrets{Secrets: []Secret{
+		{
+			Content:   base64.StdEncoding.EncodeToString(testKey),
+			ActiveAt:  now.Add(-time.Hour),
+			ExpiresAt: now.Add(time.Minute),
+		},
+		{
+			Content:   base64.StdEncoding.EncodeToString(testOtherKey),
+			ActiveAt:  now.Add(-time.Minute),
+			ExpiresAt: now.Add(time.Hour),
+		},
+		{
+			Content:   base64.StdEncoding.EncodeToString([]byte("not active yet")),
+			ActiveAt:  now.Add(time.Minute),
+			ExpiresAt: now.Add(time.Hour),
+		},
+	}})
+	if err != nil {
+		t.Fatalf("use newest secret: %v", err)
+	}
+
+	if got := string(client.currentKey()); got != string(testOtherKey) {
+		t.Errorf("key = %q, want %q", got, testOtherKey)
+	}
+
+	if err = client.UseNewestSecret(Secrets{}); !errors.Is(err, ErrNoKeys) {
+		t.Errorf("err = %v, want %v", err, ErrNoKeys)
+	}
+}
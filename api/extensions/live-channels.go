@@ -0,0 +1,99 @@
+package extensions
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/kvizyx/twitchkit/api"
+)
+
+// cursor is a pagination cursor that Twitch sends either as a string or as an object
+// with cursor field.
+type cursor string
+
+func (c *cursor) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err == nil {
+		*c = cursor(value)
+		return nil
+	}
+
+	var object struct {
+		Cursor string `json:"cursor"`
+	}
+
+	if err := json.Unmarshal(data, &object); err != nil {
+		return err
+	}
+
+	*c = cursor(object.Cursor)
+
+	return nil
+}
+
+type (
+	LiveChannel struct {
+		BroadcasterID   string `json:"broadcaster_id"`
+		BroadcasterName string `json:"broadcaster_name"`
+		GameName        string `json:"game_name"`
+		GameID          string `json:"game_id"`
+		Title           string `json:"title"`
+	}
+
+	GetLiveChannelsInput struct {
+		First int
+		After string
+	}
+
+	GetLiveChannelsOutput struct {
+		Channels []LiveChannel
+
+		// Cursor is used to get the next page of results, it's empty on the last page.
+		Cursor           string
+		ResponseMetadata api.ResponseMetadata
+	}
+)
+
+// GetLiveChannels gets a list of broadcasters that are streaming live and have
+// installed or activated the extension.
+//
+// Reference: https://dev.twitch.tv/docs/api/reference/#get-extension-live-channels
+//
+// Requires an app access token or user access token.
+func (c Client) GetLiveChannels(ctx context.Context, input GetLiveChannelsInput) (GetLiveChannelsOutput, error) {
+	const resource = "extensions/live"
+
+	values := url.Values{}
+	values.Set("extension_id", c.extensionID)
+
+	if input.First != 0 {
+		values.Set("first", strconv.Itoa(input.First))
+	}
+
+	if len(input.After) != 0 {
+		values.Set("after", input.After)
+	}
+
+	var (
+		response struct {
+			Data       []LiveChannel `json:"data"`
+			Pagination cursor        `json:"pagination"`
+		}
+		output GetLiveChannelsOutput
+	)
+
+	metadata, err := c.doHelixRequest(ctx, http.MethodGet, resource, values, nil, &response)
+	output.ResponseMetadata = metadata
+
+	if err != nil {
+		return output, err
+	}
+
+	output.Channels = response.Data
+	output.Cursor = string(response.Pagination)
+
+	return output, nil
+}
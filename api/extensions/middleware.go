@@ -0,0 +1,263 @@
+package extensions
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/kvizyx/twitchkit/api/helix"
+)
+
+var (
+	ErrMissingToken    = errors.New("extension JWT is missing")
+	ErrNoKeys          = errors.New("no active extension secrets")
+	ErrRoleNotAllowed  = errors.New("role is not allowed")
+	ErrChannelMismatch = errors.New("token is issued for another channel")
+)
+
+type claimsContextKey struct{}
+
+// ContextWithClaims returns copy of the context with the claims of verified extension JWT.
+func ContextWithClaims(ctx context.Context, claims Claims) context.Context {
+	return context.WithValue(ctx, claimsContextKey{}, claims)
+}
+
+// ClaimsFromContext returns claims put into the request context by Middleware.
+func ClaimsFromContext(ctx context.Context) (Claims, bool) {
+	claims, ok := ctx.Value(claimsContextKey{}).(Claims)
+	return claims, ok
+}
+
+// KeySource returns decoded secrets that extension JWTs may be signed with. It's called
+// for every request, so secrets can be rotated without restarting the server.
+type KeySource func(ctx context.Context) ([][]byte, error)
+
+// StaticKeys returns KeySource of the fixed base64 secrets.
+func StaticKeys(secrets ...string) (KeySource, error) {
+	keys := make([][]byte, 0, len(secrets))
+
+	for _, secret := range secrets {
+		key, err := DecodeSecret(secret)
+		if err != nil {
+			return nil, err
+		}
+
+		keys = append(keys, key)
+	}
+
+	return func(context.Context) ([][]byte, error) {
+		return keys, nil
+	}, nil
+}
+
+const (
+	// secretsRetryInterval is a minimal delay between failed attempts to get secrets.
+	secretsRetryInterval = 10 * time.Second
+
+	// secretsFetchTimeout limits request for secrets made in the background.
+	secretsFetchTimeout = 30 * time.Second
+)
+
+// RotatingKeys returns KeySource of the extension secrets that are active at the moment.
+// Secrets are got with GetSecrets and cached for the given interval. If secrets can't
+// be got, the cached ones are used until they expire, and the next attempt is made
+// after the retry delay (10 seconds or the interval, whichever is less).
+//
+// Secrets are got in the background, so requests are not blocked by it, except for the
+// first ones that have no cached secrets to use yet. GetSecrets is signed by the client's
+// secret, so the client is switched to the newest active secret after every fetch, and
+// keeps working after the secret it was created with expires. Interval must be positive.
+func (c Client) RotatingKeys(interval time.Duration) (KeySource, error) {
+	if interval <= 0 {
+		return nil, helix.InvalidIntervalError(interval)
+	}
+
+	keys := &rotatingKeys{
+		client:        c,
+		interval:      interval,
+		retryInterval: min(interval, secretsRetryInterval),
+	}
+
+	return keys.get, nil
+}
+
+type rotatingKeys struct {
+	client        Client
+	interval      time.Duration
+	retryInterval time.Duration
+
+	secrets     Secrets
+	fetchedAt   time.Time
+	attemptedAt time.Time
+	fetchErr    error
+
+	// fetching is closed when secrets that are being got are stored, it's nil if
+	// secrets are not being got.
+	fetching chan struct{}
+	locker   sync.Mutex
+}
+
+func (k *rotatingKeys) get(ctx context.Context) ([][]byte, error) {
+	k.locker.Lock()
+
+	now := time.Now()
+
+	if k.fetching == nil && now.Sub(k.fetchedAt) >= k.interval && now.Sub(k.attemptedAt) >= k.retryInterval {
+		k.fetching = make(chan struct{})
+		go k.fetch(context.WithoutCancel(ctx), k.fetching)
+	}
+
+	fetching, cached := k.fetching, !k.fetchedAt.IsZero()
+
+	k.locker.Unlock()
+
+	// there is nothing to use until the first secrets are got.
+	if !cached && fetching != nil {
+		select {
+		case <-fetching:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
+	k.locker.Lock()
+	defer k.locker.Unlock()
+
+	if k.fetchedAt.IsZero() {
+		return nil, fmt.Errorf("get secrets: %w", k.fetchErr)
+	}
+
+	return k.secrets.ActiveKeys(time.Now())
+}
+
+func (k *rotatingKeys) fetch(ctx context.Context, done chan struct{}) {
+	ctx, cancel := context.WithTimeout(ctx, secretsFetchTimeout)
+	defer cancel()
+
+	output, err := k.client.GetSecrets(ctx)
+
+	k.locker.Lock()
+	defer k.locker.Unlock()
+
+	k.attemptedAt, k.fetchErr = time.Now(), err
+	if err == nil {
+		k.secrets, k.fetchedAt = output.Secrets, k.attemptedAt
+
+		// client keeps its secret if none of the secrets is active yet.
+		_ = k.client.UseNewestSecret(output.Secrets)
+	}
+
+	k.fetching = nil
+	close(done)
+}
+
+type MiddlewareConfig struct {
+	// Keys returns decoded secrets that tokens may be signed with.
+	Keys KeySource
+
+	// Roles are roles allowed to access the handler.
+	//
+	// By default, broadcaster, moderator and viewer roles are allowed.
+	Roles []Role
+
+	// ChannelID returns ID of the channel that the request is made for (e.g. from the
+	// URL), and token must be issued for the same channel. If it's nil, token of any
+	// channel is accepted, but it must still have channel_id claim.
+	ChannelID func(r *http.Request) string
+
+	// OnError writes response to the rejected request.
+	//
+	// By default, 401 is written for missing or invalid tokens, 403 for tokens that are
+	// not allowed, and 500 if keys can't be got.
+	OnError func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// Middleware verifies the extension JWT that extension frontend sends in Authorization
+// header (with Bearer or Extension scheme). Token must be signed with one of the keys,
+// not be expired, have allowed role and be issued for the expected channel. Claims of
+// the verified token are put into the request context (see ClaimsFromContext).
+//
+// Requests without token are rejected too, including CORS preflight requests, so CORS
+// must be handled by the outer handler.
+func Middleware(cfg MiddlewareConfig) func(next http.Handler) http.Handler {
+	if len(cfg.Roles) == 0 {
+		cfg.Roles = []Role{RoleBroadcaster, RoleModerator, RoleViewer}
+	}
+
+	if cfg.OnError == nil {
+		cfg.OnError = writeMiddlewareError
+	}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			claims, err := cfg.verify(r)
+			if err != nil {
+				cfg.OnError(w, r, err)
+				return
+			}
+
+			next.ServeHTTP(w, r.WithContext(ContextWithClaims(r.Context(), claims)))
+		})
+	}
+}
+
+func (cfg MiddlewareConfig) verify(r *http.Request) (Claims, error) {
+	token, ok := TokenFromHeader(r.Header)
+	if !ok {
+		return Claims{}, ErrMissingToken
+	}
+
+	if cfg.Keys == nil {
+		return Claims{}, ErrNoKeys
+	}
+
+	keys, err := cfg.Keys(r.Context())
+	if err != nil {
+		return Claims{}, err
+	}
+
+	if len(keys) == 0 {
+		return Claims{}, ErrNoKeys
+	}
+
+	claims, err := Verify(token, keys...)
+	if err != nil {
+		return Claims{}, err
+	}
+
+	if !slices.Contains(cfg.Roles, claims.Role) {
+		return Claims{}, fmt.Errorf("%w: %s", ErrRoleNotAllowed, claims.Role)
+	}
+
+	if len(claims.ChannelID) == 0 {
+		return Claims{}, fmt.Errorf("%w: no channel_id claim", ErrInvalidToken)
+	}
+
+	if cfg.ChannelID != nil && cfg.ChannelID(r) != claims.ChannelID {
+		return Claims{}, ErrChannelMismatch
+	}
+
+	return claims, nil
+}
+
+// writeMiddlewareError writes status of the error without details, so they are not
+// leaked to the client.
+func writeMiddlewareError(w http.ResponseWriter, _ *http.Request, err error) {
+	status := http.StatusInternalServerError
+
+	switch {
+	case errors.Is(err, ErrMissingToken),
+		errors.Is(err, ErrInvalidToken),
+		errors.Is(err, ErrInvalidSignature),
+		errors.Is(err, ErrTokenExpired):
+		status = http.StatusUnauthorized
+	case errors.Is(err, ErrRoleNotAllowed), errors.Is(err, ErrChannelMismatch):
+		status = http.StatusForbidden
+	}
+
+	http.Error(w, http.StatusText(status), status)
+}
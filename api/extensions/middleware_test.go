@@ -0,0 +1,386 @@
+package extensions
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/kvizyx/twitchkit/api/helix"
+)
+
+// secretsHTTPClient responds to every request with the given secrets. If accepted is
+// not nil, requests must be signed by one of its keys, as Twitch rejects JWTs signed by
+// expired secrets.
+type secretsHTTPClient struct {
+	secrets  Secrets
+	accepted [][]byte
+	fail     bool
+	requests atomic.Int32
+}
+
+func (c *secretsHTTPClient) Do(req *http.Request) (*http.Response, error) {
+	c.requests.Add(1)
+
+	if c.fail {
+		return nil, errors.New("connection refused")
+	}
+
+	if token, _ := TokenFromHeader(req.Header); c.accepted != nil {
+		if _, err := Verify(token, c.accepted...); err != nil {
+			return &http.Response{
+				StatusCode: http.StatusUnauthorized,
+				Status:     http.StatusText(http.StatusUnauthorized),
+				Header:     http.Header{},
+				Body: io.NopCloser(strings.NewReader(
+					`{"error":"Unauthorized","status":401,"message":"invalid JWT"}`,
+				)),
+				Request: req,
+			}, nil
+		}
+	}
+
+	body, err := json.Marshal(map[string]any{"data": []Secrets{c.secrets}})
+	if err != nil {
+		return nil, err
+	}
+
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{},
+		Body:       io.NopCloser(strings.NewReader(string(body))),
+		Request:    req,
+	}, nil
+}
+
+func newTestClient(t *testing.T, httpClient *secretsHTTPClient) *Client {
+	t.Helper()
+
+	client, err := NewClient(ClientConfig{
+		ExtensionID: "extension",
+		OwnerID:     "1",
+		Secret:      base64.StdEncoding.EncodeToString(testKey),
+		HTTPClient:  httpClient,
+	})
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	return client
+}
+
+func newRotatingKeys(t *testing.T, client *Client, interval time.Duration) KeySource {
+	t.Helper()
+
+	keys, err := client.RotatingKeys(interval)
+	if err != nil {
+		t.Fatalf("rotating keys: %v", err)
+	}
+
+	return keys
+}
+
+func signTestToken(t *testing.T, mutate func(claims *Claims), key []byte) string {
+	t.Helper()
+
+	claims := testClaims()
+	if mutate != nil {
+		mutate(&claims)
+	}
+
+	token, err := Sign(claims, key)
+	if err != nil {
+		t.Fatalf("sign: %v", err)
+	}
+
+	return token
+}
+
+func TestMiddleware(t *testing.T) {
+	staticKeys, err := StaticKeys(base64.StdEncoding.EncodeToString(testKey))
+	if err != nil {
+		t.Fatalf("static keys: %v", err)
+	}
+
+	now := time.Now()
+
+	// the old secret expires soon and the new one is already active.
+	rotatingKeys := newRotatingKeys(t, newTestClient(t, &secretsHTTPClient{secrets: Secrets{Secrets: []Secret{
+		{
+			Content:   base64.StdEncoding.EncodeToString(testKey),
+			ActiveAt:  now.Add(-time.Hour),
+			ExpiresAt: now.Add(time.Minute),
+		},
+		{
+			Content:   base64.StdEncoding.EncodeToString(testOtherKey),
+			ActiveAt:  now.Add(-time.Minute),
+			ExpiresAt: now.Add(time.Hour),
+		},
+	}}}), time.Hour)
+
+	channelFromQuery := func(r *http.Request) string {
+		return r.URL.Query().Get("channel")
+	}
+
+	tests := []struct {
+		name          string
+		keys          KeySource
+		roles         []Role
+		authorization string
+		channel       string
+		wantStatus    int
+	}{
+		{
+			name:          "valid bearer",
+			keys:          staticKeys,
+			authorization: "Bearer " + signTestToken(t, nil, testKey),
+			wantStatus:    http.StatusOK,
+		},
+		{
+			name:          "valid extension scheme",
+			keys:          staticKeys,
+			authorization: "Extension " + signTestToken(t, nil, testKey),
+			wantStatus:    http.StatusOK,
+		},
+		{
+			name:          "non-bearer scheme",
+			keys:          staticKeys,
+			authorization: "Basic " + signTestToken(t, nil, testKey),
+			wantStatus:    http.StatusUnauthorized,
+		},
+		{
+			name:       "missing token",
+			keys:       staticKeys,
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name: "alg none",
+			keys: staticKeys,
+			authorization: "Bearer " + unsignedToken(t,
+				`{"alg":"none","typ":"JWT"}`, `{"role":"viewer","channel_id":"2"}`, testKey),
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name: "wrong alg",
+			keys: staticKeys,
+			authorization: "Bearer " + unsignedToken(t,
+				`{"alg":"HS384","typ":"JWT"}`, `{"role":"viewer","channel_id":"2"}`, testKey),
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name: "expired token",
+			keys: staticKeys,
+			authorization: "Bearer " + signTestToken(t, func(claims *Claims) {
+				claims.ExpiresAt = time.Now().Add(-time.Second).Unix()
+			}, testKey),
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name: "missing exp",
+			keys: staticKeys,
+			authorization: "Bearer " + signTestToken(t, func(claims *Claims) {
+				claims.ExpiresAt = 0
+			}, testKey),
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name:          "signed by unknown secret",
+			keys:          staticKeys,
+			authorization: "Bearer " + signTestToken(t, nil, testOtherKey),
+			wantStatus:    http.StatusUnauthorized,
+		},
+		{
+			name:          "wrong role",
+			keys:          staticKeys,
+			roles:         []Role{RoleBroadcaster},
+			authorization: "Bearer " + signTestToken(t, nil, testKey),
+			wantStatus:    http.StatusForbidden,
+		},
+		{
+			name: "external role is not allowed by default",
+			keys: staticKeys,
+			authorization: "Bearer " + signTestToken(t, func(claims *Claims) {
+				claims.Role = RoleExternal
+			}, testKey),
+			wantStatus: http.StatusForbidden,
+		},
+		{
+			name:          "channel mismatch",
+			keys:          staticKeys,
+			authorization: "Bearer " + signTestToken(t, nil, testKey),
+			channel:       "3",
+			wantStatus:    http.StatusForbidden,
+		},
+		{
+			name:          "channel match",
+			keys:          staticKeys,
+			authorization: "Bearer " + signTestToken(t, nil, testKey),
+			channel:       "2",
+			wantStatus:    http.StatusOK,
+		},
+		{
+			name: "missing channel",
+			keys: staticKeys,
+			authorization: "Bearer " + signTestToken(t, func(claims *Claims) {
+				claims.ChannelID = ""
+			}, testKey),
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name:          "old secret during rotation",
+			keys:          rotatingKeys,
+			authorization: "Bearer " + signTestToken(t, nil, testKey),
+			wantStatus:    http.StatusOK,
+		},
+		{
+			name:          "new secret during rotation",
+			keys:          rotatingKeys,
+			authorization: "Bearer " + signTestToken(t, nil, testOtherKey),
+			wantStatus:    http.StatusOK,
+		},
+		{
+			name:          "no keys",
+			authorization: "Bearer " + signTestToken(t, nil, testKey),
+			wantStatus:    http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotClaims Claims
+
+			cfg := MiddlewareConfig{Keys: tt.keys, Roles: tt.roles}
+			if len(tt.channel) != 0 {
+				cfg.ChannelID = channelFromQuery
+			}
+
+			handler := Middleware(cfg)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				gotClaims, _ = ClaimsFromContext(r.Context())
+			}))
+
+			target := "/"
+			if len(tt.channel) != 0 {
+				target += "?channel=" + tt.channel
+			}
+
+			req := httptest.NewRequest(http.MethodGet, target, nil)
+			if len(tt.authorization) != 0 {
+				req.Header.Set("Authorization", tt.authorization)
+			}
+
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, req)
+
+			if recorder.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d", recorder.Code, tt.wantStatus)
+			}
+
+			if tt.wantStatus == http.StatusOK && gotClaims.ChannelID != "2" {
+				t.Errorf("claims from context = %+v", gotClaims)
+			}
+		})
+	}
+}
+
+func TestRotatingKeysBackOff(t *testing.T) {
+	httpClient := &secretsHTTPClient{fail: true}
+	keys := newRotatingKeys(t, newTestClient(t, httpClient), time.Hour)
+
+	for range 3 {
+		if _, err := keys(context.Background()); err == nil {
+			t.Fatal("got keys from failing client")
+		}
+	}
+
+	// failed attempt is not repeated until the retry delay passes.
+	if requests := httpClient.requests.Load(); requests != 1 {
+		t.Errorf("sent %d requests, want 1", requests)
+	}
+}
+
+func TestRotatingKeysCache(t *testing.T) {
+	now := time.Now()
+
+	httpClient := &secretsHTTPClient{secrets: Secrets{Secrets: []Secret{{
+		Content:   base64.StdEncoding.EncodeToString(testKey),
+		ActiveAt:  now.Add(-time.Hour),
+		ExpiresAt: now.Add(time.Hour),
+	}}}}
+
+	keys := newRotatingKeys(t, newTestClient(t, httpClient), time.Hour)
+
+	for range 3 {
+		got, err := keys(context.Background())
+		if err != nil {
+			t.Fatalf("keys: %v", err)
+		}
+
+		if len(got) != 1 || string(got[0]) != string(testKey) {
+			t.Fatalf("keys = %q, want [%q]", got, testKey)
+		}
+	}
+
+	if requests := httpClient.requests.Load(); requests != 1 {
+		t.Errorf("sent %d requests, want 1", requests)
+	}
+}
+
+func TestRotatingKeysInvalidInterval(t *testing.T) {
+	client := newTestClient(t, &secretsHTTPClient{})
+
+	for _, interval := range []time.Duration{0, -time.Second} {
+		if _, err := client.RotatingKeys(interval); !errors.Is(err, helix.ErrInvalidInterval) {
+			t.Errorf("interval %s: err = %v, want %v", interval, err, helix.ErrInvalidInterval)
+		}
+	}
+}
+
+func TestRotatingKeysSwitchesClientSecret(t *testing.T) {
+	now := time.Now()
+
+	oldSecret := Secret{
+		Content:   base64.StdEncoding.EncodeToString(testKey),
+		ActiveAt:  now.Add(-time.Hour),
+		ExpiresAt: now.Add(time.Minute),
+	}
+
+	newSecret := Secret{
+		Content:   base64.StdEncoding.EncodeToString(testOtherKey),
+		ActiveAt:  now.Add(-time.Minute),
+		ExpiresAt: now.Add(time.Hour),
+	}
+
+	httpClient := &secretsHTTPClient{
+		secrets:  Secrets{Secrets: []Secret{oldSecret, newSecret}},
+		accepted: [][]byte{testKey, testOtherKey},
+	}
+
+	client := newTestClient(t, httpClient)
+
+	keys := &rotatingKeys{client: *client, interval: time.Hour, retryInterval: time.Second}
+
+	keys.fetch(context.Background(), make(chan struct{}))
+	if keys.fetchErr != nil {
+		t.Fatalf("first fetch: %v", keys.fetchErr)
+	}
+
+	// the secret client was created with expires, so Twitch accepts only the new one.
+	httpClient.secrets = Secrets{Secrets: []Secret{newSecret}}
+	httpClient.accepted = [][]byte{testOtherKey}
+
+	keys.fetch(context.Background(), make(chan struct{}))
+	if keys.fetchErr != nil {
+		t.Fatalf("fetch after the old secret expired: %v", keys.fetchErr)
+	}
+
+	if got := string(client.currentKey()); got != string(testOtherKey) {
+		t.Errorf("client key = %q, want %q", got, testOtherKey)
+	}
+}
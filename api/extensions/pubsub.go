@@ -0,0 +1,60 @@
+package extensions
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/kvizyx/twitchkit/api"
+	"github.com/kvizyx/twitchkit/http-core"
+)
+
+const (
+	// TargetBroadcast sends the message to all viewers of the channel.
+	TargetBroadcast = "broadcast"
+
+	// TargetGlobal sends the message to all viewers of all channels the extension is
+	// active on.
+	TargetGlobal = "global"
+)
+
+// WhisperTarget returns target that sends the message only to the viewer with the
+// given opaque user ID.
+func WhisperTarget(opaqueUserID string) string {
+	return "whisper-" + opaqueUserID
+}
+
+type SendPubSubMessageInput struct {
+	// Targets are TargetBroadcast, TargetGlobal or targets returned by WhisperTarget.
+	Targets []string `json:"target"`
+
+	// BroadcasterID is required unless IsGlobalBroadcast is true.
+	BroadcasterID     string `json:"broadcaster_id,omitempty"`
+	IsGlobalBroadcast bool   `json:"is_global_broadcast,omitempty"`
+
+	// Message is limited to 5 KB.
+	Message string `json:"message"`
+}
+
+// SendPubSubMessage sends a message to one or more viewers of the extension. Messages
+// are limited to 100 per minute per channel.
+//
+// Reference: https://dev.twitch.tv/docs/api/reference/#send-extension-pubsub-message
+//
+// Requires a JWT signed by the extension secret.
+func (c Client) SendPubSubMessage(ctx context.Context, input SendPubSubMessageInput) (api.ResponseMetadata, error) {
+	const resource = "extensions/pubsub"
+
+	claims := c.externalClaims()
+	claims.ChannelID = input.BroadcasterID
+	claims.PubSubPerms = &PubSubPerms{Send: input.Targets}
+
+	if input.IsGlobalBroadcast {
+		claims.ChannelID = "all"
+	}
+
+	return c.doSignedRequest(ctx, httpcore.RequestOptions{
+		Resource: resource,
+		Method:   http.MethodPost,
+		Body:     input,
+	}, claims, nil)
+}
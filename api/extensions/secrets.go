@@ -0,0 +1,122 @@
+package extensions
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/kvizyx/twitchkit/api"
+	"github.com/kvizyx/twitchkit/http-core"
+)
+
+type (
+	Secret struct {
+		// Content is a base64 secret, use DecodeSecret to get the key from it.
+		Content   string    `json:"content"`
+		ActiveAt  time.Time `json:"active_at"`
+		ExpiresAt time.Time `json:"expires_at"`
+	}
+
+	Secrets struct {
+		FormatVersion int      `json:"format_version"`
+		Secrets       []Secret `json:"secrets"`
+	}
+
+	SecretsOutput struct {
+		Secrets          Secrets
+		ResponseMetadata api.ResponseMetadata
+	}
+)
+
+// IsActive reports whether the secret is valid at the given time.
+func (s Secret) IsActive(at time.Time) bool {
+	return !at.Before(s.ActiveAt) && at.Before(s.ExpiresAt)
+}
+
+// ActiveKeys returns decoded keys of the secrets that are valid at the given time. There
+// may be a few of them while secrets are rotated, and tokens signed by any of them are
+// valid.
+func (s Secrets) ActiveKeys(at time.Time) ([][]byte, error) {
+	var keys [][]byte
+
+	for _, secret := range s.Secrets {
+		if !secret.IsActive(at) {
+			continue
+		}
+
+		key, err := DecodeSecret(secret.Content)
+		if err != nil {
+			return nil, err
+		}
+
+		keys = append(keys, key)
+	}
+
+	return keys, nil
+}
+
+// GetSecrets gets the list of shared secrets of the extension.
+//
+// Reference: https://dev.twitch.tv/docs/api/reference/#get-extension-secrets
+//
+// Requires a JWT signed by the extension secret.
+func (c Client) GetSecrets(ctx context.Context) (SecretsOutput, error) {
+	const resource = "extensions/jwt/secrets"
+
+	values := url.Values{}
+	values.Set("extension_id", c.extensionID)
+
+	return c.doSecretsRequest(ctx, http.MethodGet, resource, values)
+}
+
+// CreateSecret creates a shared secret for the extension, which becomes active after the
+// delay (at least 300 seconds, which is also used if delay is zero). The current secrets
+// stay active until the new one becomes active.
+//
+// Reference: https://dev.twitch.tv/docs/api/reference/#create-extension-secret
+//
+// Requires a JWT signed by the extension secret.
+func (c Client) CreateSecret(ctx context.Context, delay time.Duration) (SecretsOutput, error) {
+	const resource = "extensions/jwt/secrets"
+
+	values := url.Values{}
+	values.Set("extension_id", c.extensionID)
+
+	if delay > 0 {
+		values.Set("delay", strconv.Itoa(int(delay/time.Second)))
+	}
+
+	return c.doSecretsRequest(ctx, http.MethodPost, resource, values)
+}
+
+func (c Client) doSecretsRequest(
+	ctx context.Context,
+	method, resource string,
+	values url.Values,
+) (SecretsOutput, error) {
+	var (
+		wrapper struct {
+			Data []Secrets `json:"data"`
+		}
+		output SecretsOutput
+	)
+
+	metadata, err := c.doSignedRequest(ctx, httpcore.RequestOptions{
+		Resource:  resource,
+		Method:    method,
+		URLValues: values,
+	}, c.externalClaims(), &wrapper)
+	output.ResponseMetadata = metadata
+
+	if err != nil {
+		return output, err
+	}
+
+	if len(wrapper.Data) != 0 {
+		output.Secrets = wrapper.Data[0]
+	}
+
+	return output, nil
+}
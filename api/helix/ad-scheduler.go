@@ -0,0 +1,140 @@
+package helix
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// AdScheduler runs commercials on the broadcaster's channel, either on demand at segment
+// breaks or automatically ahead of the ads that Twitch schedules. It keeps track of the
+// cooldown that Twitch reports after every commercial (StartCommercialOutput.RetryAfter)
+// and never starts a commercial before the cooldown ends.
+type AdScheduler struct {
+	ads           AdsResource
+	broadcasterID string
+	length        int
+
+	readyAt time.Time
+	locker  sync.Mutex
+}
+
+// NewAdScheduler creates AdScheduler that runs commercials of the given length (in
+// seconds) on the broadcaster's channel.
+func (r AdsResource) NewAdScheduler(broadcasterID string, length int) *AdScheduler {
+	return &AdScheduler{
+		ads:           r,
+		broadcasterID: broadcasterID,
+		length:        length,
+	}
+}
+
+// ReadyAt returns time when the cooldown of the last commercial ends. It's zero if no
+// commercial was started by the scheduler yet.
+func (as *AdScheduler) ReadyAt() time.Time {
+	as.locker.Lock()
+	defer as.locker.Unlock()
+
+	return as.readyAt
+}
+
+// Break starts a commercial right away, which is meant to be called at segment breaks.
+// CommercialCooldownError is returned if the cooldown of the previous commercial is not
+// over yet.
+func (as *AdScheduler) Break(ctx context.Context) (StartCommercialOutput, error) {
+	as.locker.Lock()
+	defer as.locker.Unlock()
+
+	if time.Now().Before(as.readyAt) {
+		return StartCommercialOutput{}, CommercialCooldownError(as.readyAt)
+	}
+
+	output, err := as.ads.StartCommercial(ctx, StartCommercialInput{
+		BroadcasterID: as.broadcasterID,
+		Length:        as.length,
+	})
+	if err != nil {
+		return output, err
+	}
+
+	as.readyAt = time.Now().Add(time.Duration(output.RetryAfter) * time.Second)
+
+	return output, nil
+}
+
+// Run checks the ad schedule with the given interval until context is done, and starts
+// a commercial lead time before every ad scheduled by Twitch, so ads run when it's
+// expected instead of at a random point. If the cooldown is not over by then, the
+// commercial is started as soon as it ends. Started commercials are reported to
+// onCommercial, and failures are reported to onError (if it's not nil) and do not stop
+// the scheduler. Interval must be positive.
+func (as *AdScheduler) Run(
+	ctx context.Context,
+	interval, lead time.Duration,
+	onCommercial func(output StartCommercialOutput),
+	onError func(err error),
+) error {
+	if interval <= 0 {
+		return InvalidIntervalError(interval)
+	}
+
+	for {
+		wait := as.tick(ctx, interval, lead, onCommercial, onError)
+
+		timer := time.NewTimer(wait)
+
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// tick starts a commercial if it's time to, and returns how long to wait for the next tick.
+func (as *AdScheduler) tick(
+	ctx context.Context,
+	interval, lead time.Duration,
+	onCommercial func(output StartCommercialOutput),
+	onError func(err error),
+) time.Duration {
+	schedule, err := as.ads.GetAdSchedule(ctx, as.broadcasterID)
+	if err != nil {
+		if onError != nil {
+			onError(err)
+		}
+
+		return interval
+	}
+
+	// channel is offline or has no ads scheduled.
+	nextAdAt := schedule.AdSchedule.NextAdAt
+	if nextAdAt.IsZero() {
+		return interval
+	}
+
+	startAt := nextAdAt.Add(-lead)
+	if readyAt := as.ReadyAt(); startAt.Before(readyAt) {
+		startAt = readyAt
+	}
+
+	if until := time.Until(startAt); until > 0 {
+		return min(interval, until)
+	}
+
+	output, err := as.Break(ctx)
+	if err != nil {
+		if onError != nil {
+			onError(err)
+		}
+
+		return interval
+	}
+
+	if onCommercial != nil {
+		onCommercial(output)
+	}
+
+	return interval
+}
@@ -0,0 +1,241 @@
+package helix
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/kvizyx/twitchkit/http-core"
+)
+
+// analyticsDateLayouts are layouts of the dates seen in the analytics reports.
+var analyticsDateLayouts = []string{
+	time.RFC3339,
+	time.DateOnly,
+	time.DateTime,
+	"01/02/2006",
+}
+
+// AnalyticsReport is a parsed CSV analytics report.
+type AnalyticsReport struct {
+	Columns []string
+	Rows    []AnalyticsRow
+}
+
+// AnalyticsRow is a single row of the analytics report (usually, data for a single day).
+// Empty cells are treated as zero values.
+type AnalyticsRow struct {
+	values map[string]string
+}
+
+// String returns value of the column as is.
+func (ar AnalyticsRow) String(column string) string {
+	return ar.values[column]
+}
+
+// Int returns value of the column as integer.
+func (ar AnalyticsRow) Int(column string) (int64, error) {
+	value := ar.values[column]
+	if len(value) == 0 {
+		return 0, nil
+	}
+
+	return strconv.ParseInt(value, 10, 64)
+}
+
+// Float returns value of the column as floating point number.
+func (ar AnalyticsRow) Float(column string) (float64, error) {
+	value := ar.values[column]
+	if len(value) == 0 {
+		return 0, nil
+	}
+
+	return strconv.ParseFloat(value, 64)
+}
+
+// Time returns value of the column as time in UTC.
+func (ar AnalyticsRow) Time(column string) (time.Time, error) {
+	value := ar.values[column]
+	if len(value) == 0 {
+		return time.Time{}, nil
+	}
+
+	for _, layout := range analyticsDateLayouts {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t.UTC(), nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("unknown date format of %q", value)
+}
+
+// DownloadReport downloads the CSV report from URL of ExtensionAnalyticsReport or
+// GameAnalyticsReport and parses it. Report URLs expire after 5 minutes, so the report
+// should be downloaded right after it's requested. Report is downloaded with the plain
+// ClientConfig.HTTPClient, since the URL is pre-signed and not a part of Twitch API.
+func (r AnalyticsResource) DownloadReport(ctx context.Context, reportURL string) (AnalyticsReport, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, reportURL, nil)
+	if err != nil {
+		return AnalyticsReport{}, fmt.Errorf("new request: %w", err)
+	}
+
+	res, err := r.client.baseHTTPClient.Do(req)
+	if err != nil {
+		return AnalyticsReport{}, fmt.Errorf("do request: %w", err)
+	}
+	defer func() {
+		_ = res.Body.Close()
+	}()
+
+	if res.StatusCode != http.StatusOK {
+		return AnalyticsReport{}, httpcore.UnsuccessfulRequestError(res.Status)
+	}
+
+	return ParseAnalyticsReport(res.Body)
+}
+
+// ParseAnalyticsReport parses CSV analytics report. The first record is expected to be
+// the header with column names.
+func ParseAnalyticsReport(reader io.Reader) (AnalyticsReport, error) {
+	csvReader := csv.NewReader(reader)
+	csvReader.FieldsPerRecord = -1
+
+	columns, err := csvReader.Read()
+	if err == io.EOF {
+		return AnalyticsReport{}, nil
+	}
+
+	if err != nil {
+		return AnalyticsReport{}, fmt.Errorf("read header: %w", err)
+	}
+
+	if len(columns) != 0 {
+		columns[0] = strings.TrimPrefix(columns[0], "\ufeff")
+	}
+
+	report := AnalyticsReport{Columns: columns}
+
+	for {
+		record, err := csvReader.Read()
+		if err == io.EOF {
+			break
+		}
+
+		if err != nil {
+			return AnalyticsReport{}, fmt.Errorf("read record: %w", err)
+		}
+
+		row := AnalyticsRow{values: make(map[string]string, len(columns))}
+		for i, value := range record {
+			if i < len(columns) {
+				row.values[columns[i]] = strings.TrimSpace(value)
+			}
+		}
+
+		report.Rows = append(report.Rows, row)
+	}
+
+	return report, nil
+}
+
+// DecodeAnalyticsReport decodes rows of the report into structs of type T. Fields of T
+// are mapped to the columns with `csv:"Column Name"` tags, and fields without the tag
+// are skipped. Supported field types are strings, integers, floating point numbers,
+// booleans and time.Time. Tagged fields must be exported, and values that overflow the
+// field type are not decoded.
+func DecodeAnalyticsReport[T any](report AnalyticsReport) ([]T, error) {
+	rowType := reflect.TypeFor[T]()
+	if rowType.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("%s is not a struct", rowType)
+	}
+
+	rows := make([]T, 0, len(report.Rows))
+
+	for i, row := range report.Rows {
+		var decoded T
+
+		value := reflect.ValueOf(&decoded).Elem()
+
+		for j := 0; j < rowType.NumField(); j++ {
+			column, ok := rowType.Field(j).Tag.Lookup("csv")
+			if !ok || column == "-" {
+				continue
+			}
+
+			if err := setAnalyticsField(value.Field(j), row, column); err != nil {
+				return nil, fmt.Errorf("row %d: column %q: %w", i+1, column, err)
+			}
+		}
+
+		rows = append(rows, decoded)
+	}
+
+	return rows, nil
+}
+
+func setAnalyticsField(field reflect.Value, row AnalyticsRow, column string) error {
+	if !field.CanSet() {
+		return errors.New("field is not exported")
+	}
+
+	if field.Type() == reflect.TypeFor[time.Time]() {
+		t, err := row.Time(column)
+		if err != nil {
+			return err
+		}
+
+		field.Set(reflect.ValueOf(t))
+
+		return nil
+	}
+
+	switch field.Kind() {
+	case reflect.String:
+		field.SetString(row.String(column))
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := row.Int(column)
+		if err != nil {
+			return err
+		}
+
+		if field.OverflowInt(n) {
+			return fmt.Errorf("value %d overflows %s", n, field.Type())
+		}
+
+		field.SetInt(n)
+	case reflect.Float32, reflect.Float64:
+		f, err := row.Float(column)
+		if err != nil {
+			return err
+		}
+
+		if field.OverflowFloat(f) {
+			return fmt.Errorf("value %g overflows %s", f, field.Type())
+		}
+
+		field.SetFloat(f)
+	case reflect.Bool:
+		value := row.String(column)
+		if len(value) == 0 {
+			return nil
+		}
+
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return err
+		}
+
+		field.SetBool(b)
+	default:
+		return fmt.Errorf("unsupported field type %s", field.Type())
+	}
+
+	return nil
+}
@@ -0,0 +1,227 @@
+package helix
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kvizyx/twitchkit/http-core"
+)
+
+// testAnalyticsReport starts with UTF-8 BOM and has empty cells and different date
+// layouts, as reports do.
+const testAnalyticsReport = "\ufeffDate,Extension Client ID,Installs,Revenue,Featured\n" +
+	"2026-10-01,abc, 12 ,1.5,true\n" +
+	"10/02/2026,abc,,,\n" +
+	"2026-10-03T00:00:00Z,abc,3,0.25,false\n"
+
+type testAnalyticsRow struct {
+	Date     time.Time `csv:"Date"`
+	ClientID string    `csv:"Extension Client ID"`
+	Installs int       `csv:"Installs"`
+	Revenue  float64   `csv:"Revenue"`
+	Featured bool      `csv:"Featured"`
+	Skipped  string
+}
+
+func TestParseAnalyticsReport(t *testing.T) {
+	report, err := ParseAnalyticsReport(strings.NewReader(testAnalyticsReport))
+	if err != nil {
+		t.Fatalf("parse report: %v", err)
+	}
+
+	if report.Columns[0] != "Date" {
+		t.Errorf("first column = %q, want BOM to be stripped", report.Columns[0])
+	}
+
+	if len(report.Rows) != 3 {
+		t.Fatalf("got %d rows, want 3", len(report.Rows))
+	}
+
+	installs, err := report.Rows[0].Int("Installs")
+	if err != nil || installs != 12 {
+		t.Errorf("installs = %d, %v, want 12", installs, err)
+	}
+
+	// empty cells are zero values.
+	if installs, err = report.Rows[1].Int("Installs"); err != nil || installs != 0 {
+		t.Errorf("empty installs = %d, %v, want 0", installs, err)
+	}
+
+	if revenue, err := report.Rows[1].Float("Revenue"); err != nil || revenue != 0 {
+		t.Errorf("empty revenue = %f, %v, want 0", revenue, err)
+	}
+
+	// missing columns are empty too.
+	if missing := report.Rows[0].String("Unknown"); len(missing) != 0 {
+		t.Errorf("missing column = %q, want empty", missing)
+	}
+
+	for i, want := range []time.Time{
+		time.Date(2026, time.October, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2026, time.October, 2, 0, 0, 0, 0, time.UTC),
+		time.Date(2026, time.October, 3, 0, 0, 0, 0, time.UTC),
+	} {
+		date, err := report.Rows[i].Time("Date")
+		if err != nil || !date.Equal(want) {
+			t.Errorf("row %d: date = %s, %v, want %s", i, date, err, want)
+		}
+	}
+
+	empty, err := ParseAnalyticsReport(strings.NewReader(""))
+	if err != nil || len(empty.Columns) != 0 {
+		t.Errorf("empty report = %+v, %v", empty, err)
+	}
+}
+
+func TestDecodeAnalyticsReport(t *testing.T) {
+	report, err := ParseAnalyticsReport(strings.NewReader(testAnalyticsReport))
+	if err != nil {
+		t.Fatalf("parse report: %v", err)
+	}
+
+	rows, err := DecodeAnalyticsReport[testAnalyticsRow](report)
+	if err != nil {
+		t.Fatalf("decode report: %v", err)
+	}
+
+	want := []testAnalyticsRow{
+		{
+			Date:     time.Date(2026, time.October, 1, 0, 0, 0, 0, time.UTC),
+			ClientID: "abc",
+			Installs: 12,
+			Revenue:  1.5,
+			Featured: true,
+		},
+		{
+			Date:     time.Date(2026, time.October, 2, 0, 0, 0, 0, time.UTC),
+			ClientID: "abc",
+		},
+		{
+			Date:     time.Date(2026, time.October, 3, 0, 0, 0, 0, time.UTC),
+			ClientID: "abc",
+			Installs: 3,
+			Revenue:  0.25,
+		},
+	}
+
+	if len(rows) != len(want) {
+		t.Fatalf("got %d rows, want %d", len(rows), len(want))
+	}
+
+	for i := range want {
+		if rows[i] != want[i] {
+			t.Errorf("row %d = %+v, want %+v", i, rows[i], want[i])
+		}
+	}
+
+	invalid, err := ParseAnalyticsReport(strings.NewReader("Date\nyesterday\n"))
+	if err != nil {
+		t.Fatalf("parse report: %v", err)
+	}
+
+	if _, err = DecodeAnalyticsReport[testAnalyticsRow](invalid); err == nil {
+		t.Error("unknown date layout is decoded")
+	}
+}
+
+func TestDecodeAnalyticsReportInvalidFields(t *testing.T) {
+	report, err := ParseAnalyticsReport(strings.NewReader("Installs,Revenue\n300,1e39\n"))
+	if err != nil {
+		t.Fatalf("parse report: %v", err)
+	}
+
+	type unexportedRow struct {
+		installs int `csv:"Installs"`
+	}
+
+	type smallIntRow struct {
+		Installs int8 `csv:"Installs"`
+	}
+
+	type smallFloatRow struct {
+		Revenue float32 `csv:"Revenue"`
+	}
+
+	tests := []struct {
+		name    string
+		decode  func() error
+		wantErr string
+	}{
+		{
+			name: "unexported field",
+			decode: func() error {
+				_, err := DecodeAnalyticsReport[unexportedRow](report)
+				return err
+			},
+			wantErr: "not exported",
+		},
+		{
+			name: "int overflow",
+			decode: func() error {
+				_, err := DecodeAnalyticsReport[smallIntRow](report)
+				return err
+			},
+			wantErr: "overflows int8",
+		},
+		{
+			name: "float overflow",
+			decode: func() error {
+				_, err := DecodeAnalyticsReport[smallFloatRow](report)
+				return err
+			},
+			wantErr: "overflows float32",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.decode(); err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("err = %v, want it to contain %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestDownloadReportSkipsMiddlewares(t *testing.T) {
+	httpClient := &fakeHTTPClient{responses: []fakeResponse{
+		{status: http.StatusOK, body: testAnalyticsReport},
+	}}
+
+	middlewareCalls := 0
+
+	client, err := NewClient(ClientConfig{
+		AuthProvider: &fakeAuthProvider{},
+		HTTPClient:   httpClient,
+		Middlewares: []httpcore.Middleware{
+			func(next httpcore.HTTPClient) httpcore.HTTPClient {
+				return httpcore.HTTPClientFunc(func(req *http.Request) (*http.Response, error) {
+					middlewareCalls++
+					return next.Do(req)
+				})
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	report, err := client.Analytics().DownloadReport(context.Background(), "https://example.com/report.csv")
+	if err != nil {
+		t.Fatalf("download report: %v", err)
+	}
+
+	if len(report.Rows) != 3 {
+		t.Errorf("got %d rows, want 3", len(report.Rows))
+	}
+
+	if middlewareCalls != 0 {
+		t.Errorf("middleware is called %d times for report download", middlewareCalls)
+	}
+
+	if sent := httpClient.requests(); len(sent) != 1 || len(sent[0].authorization) != 0 {
+		t.Errorf("sent requests = %+v, want one without authorization", sent)
+	}
+}
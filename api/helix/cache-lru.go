@@ -0,0 +1,84 @@
+package helix
+
+import (
+	"container/list"
+	"context"
+	"sync"
+)
+
+// LRUCache is in-memory CacheBackend that evicts least recently used responses
+// when its size is exceeded.
+type LRUCache struct {
+	size    int
+	entries map[string]*list.Element
+	order   *list.List
+	locker  sync.Mutex
+}
+
+var _ CacheBackend = &LRUCache{}
+
+type lruEntry struct {
+	key      string
+	response CachedResponse
+}
+
+// NewLRUCache creates LRUCache that holds up to size responses. DefaultCacheSize is
+// used if size is not positive.
+func NewLRUCache(size int) *LRUCache {
+	if size <= 0 {
+		size = DefaultCacheSize
+	}
+
+	return &LRUCache{
+		size:    size,
+		entries: make(map[string]*list.Element),
+		order:   list.New(),
+	}
+}
+
+func (lc *LRUCache) Get(_ context.Context, key string) (CachedResponse, bool, error) {
+	lc.locker.Lock()
+	defer lc.locker.Unlock()
+
+	element, found := lc.entries[key]
+	if !found {
+		return CachedResponse{}, false, nil
+	}
+
+	lc.order.MoveToFront(element)
+
+	return element.Value.(*lruEntry).response, true, nil
+}
+
+func (lc *LRUCache) Set(_ context.Context, key string, response CachedResponse) error {
+	lc.locker.Lock()
+	defer lc.locker.Unlock()
+
+	if element, found := lc.entries[key]; found {
+		element.Value.(*lruEntry).response = response
+		lc.order.MoveToFront(element)
+
+		return nil
+	}
+
+	lc.entries[key] = lc.order.PushFront(&lruEntry{
+		key:      key,
+		response: response,
+	})
+
+	for lc.order.Len() > lc.size {
+		oldest := lc.order.Back()
+		lc.order.Remove(oldest)
+		delete(lc.entries, oldest.Value.(*lruEntry).key)
+	}
+
+	return nil
+}
+
+// Len returns number of responses in the cache.
+func (lc *LRUCache) Len() int {
+	lc.locker.Lock()
+	defer lc.locker.Unlock()
+
+	return lc.order.Len()
+}
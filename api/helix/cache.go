@@ -0,0 +1,208 @@
+package helix
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/kvizyx/twitchkit/http-core"
+)
+
+// DefaultCacheSize is a number of responses kept by the default cache backend.
+const DefaultCacheSize = 1024
+
+// DefaultCacheTTL contains endpoints with rarely changing data and how long their
+// responses are cached by default.
+var DefaultCacheTTL = map[string]time.Duration{
+	"chat/badges/global":            time.Hour,
+	"chat/emotes/global":            time.Hour,
+	"bits/cheermotes":               time.Hour,
+	"games":                         time.Hour,
+	"content_classification_labels": 24 * time.Hour,
+}
+
+// rateLimitHeaders describe the rate-limit bucket at the moment of the response, so
+// they are not stored in the cache, and responses served from it don't report stale
+// rate-limit state.
+var rateLimitHeaders = []string{"RateLimit-Limit", "RateLimit-Remaining", "RateLimit-Reset"}
+
+// CachedResponse is a successful response stored in the cache. Rate-limit headers are
+// not stored.
+type CachedResponse struct {
+	Header    http.Header
+	Body      []byte
+	ETag      string
+	ExpiresAt time.Time
+}
+
+// CacheBackend stores cached responses. Implementations should be safe for concurrent
+// use. Entries are expected to be kept after they are expired, so they can be
+// revalidated with ETag (if server provided one).
+type CacheBackend interface {
+	Get(ctx context.Context, key string) (CachedResponse, bool, error)
+	Set(ctx context.Context, key string, response CachedResponse) error
+}
+
+// CacheConfig is a configuration for Client's response cache. Only GET requests to
+// endpoints listed in EndpointTTL are cached.
+type CacheConfig struct {
+	// Backend stores cached responses.
+	//
+	// By default, in-memory LRU cache of DefaultCacheSize responses is used.
+	Backend CacheBackend
+
+	// EndpointTTL is a time to live of cached responses by endpoint (Helix resource
+	// without base URL, e.g. "chat/badges/global").
+	//
+	// By default, it's DefaultCacheTTL.
+	EndpointTTL map[string]time.Duration
+}
+
+// cachingHTTPClient serves responses for cacheable requests from the cache, and
+// stores fresh ones in it. Cache key consists of the request URL and identity of the
+// access token, so responses are never shared between different tokens.
+type cachingHTTPClient struct {
+	next        httpcore.HTTPClient
+	backend     CacheBackend
+	endpointTTL map[string]time.Duration
+}
+
+func newCachingHTTPClient(next httpcore.HTTPClient, cfg CacheConfig) cachingHTTPClient {
+	if cfg.Backend == nil {
+		cfg.Backend = NewLRUCache(DefaultCacheSize)
+	}
+
+	if cfg.EndpointTTL == nil {
+		cfg.EndpointTTL = DefaultCacheTTL
+	}
+
+	return cachingHTTPClient{
+		next:        next,
+		backend:     cfg.Backend,
+		endpointTTL: cfg.EndpointTTL,
+	}
+}
+
+// Do does the request or serves it from the cache. Backend errors are not fatal: the
+// request is made as if there is no cache.
+func (cc cachingHTTPClient) Do(req *http.Request) (*http.Response, error) {
+	ttl, ok := cc.endpointTTL[endpointOf(req)]
+	if !ok || ttl <= 0 || req.Method != http.MethodGet {
+		return cc.next.Do(req)
+	}
+
+	var (
+		ctx = req.Context()
+		key = cacheKey(req)
+	)
+
+	cached, found, err := cc.backend.Get(ctx, key)
+	if err != nil {
+		found = false
+	}
+
+	if found && time.Now().Before(cached.ExpiresAt) {
+		markCacheHit(req)
+		return cached.response(req), nil
+	}
+
+	if found && len(cached.ETag) != 0 {
+		req = req.Clone(ctx)
+		req.Header.Set("If-None-Match", cached.ETag)
+	}
+
+	res, err := cc.next.Do(req)
+	if err != nil {
+		return res, err
+	}
+
+	if found && res.StatusCode == http.StatusNotModified {
+		_ = res.Body.Close()
+
+		cached.ExpiresAt = time.Now().Add(ttl)
+		_ = cc.backend.Set(ctx, key, cached)
+
+		// revalidation request was actually made, so its rate-limit state is current.
+		response := cached.response(req)
+		for _, header := range rateLimitHeaders {
+			if value := res.Header.Get(header); len(value) != 0 {
+				response.Header.Set(header, value)
+			}
+		}
+
+		return response, nil
+	}
+
+	if res.StatusCode != http.StatusOK {
+		return res, nil
+	}
+
+	body, err := io.ReadAll(res.Body)
+	_ = res.Body.Close()
+
+	if err != nil {
+		return nil, fmt.Errorf("read response body: %w", err)
+	}
+
+	header := res.Header.Clone()
+	for _, name := range rateLimitHeaders {
+		header.Del(name)
+	}
+
+	_ = cc.backend.Set(ctx, key, CachedResponse{
+		Header:    header,
+		Body:      body,
+		ETag:      res.Header.Get("ETag"),
+		ExpiresAt: time.Now().Add(ttl),
+	})
+
+	res.Body = io.NopCloser(bytes.NewReader(body))
+
+	return res, nil
+}
+
+func (cr CachedResponse) response(req *http.Request) *http.Response {
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", http.StatusOK, http.StatusText(http.StatusOK)),
+		StatusCode:    http.StatusOK,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        cr.Header.Clone(),
+		Body:          io.NopCloser(bytes.NewReader(cr.Body)),
+		ContentLength: int64(len(cr.Body)),
+		Request:       req,
+	}
+}
+
+type cacheHitContextKey struct{}
+
+// withCacheHitFlag returns copy of the request with a flag that is set if response is
+// served from the cache, so such responses are not recorded as requests to Twitch.
+func withCacheHitFlag(req *http.Request) (*http.Request, *bool) {
+	cacheHit := new(bool)
+	ctx := context.WithValue(req.Context(), cacheHitContextKey{}, cacheHit)
+
+	return req.WithContext(ctx), cacheHit
+}
+
+func markCacheHit(req *http.Request) {
+	if cacheHit, ok := req.Context().Value(cacheHitContextKey{}).(*bool); ok {
+		*cacheHit = true
+	}
+}
+
+// cacheKey returns key of the request in the cache. Access token is hashed, so it's
+// not exposed to the cache backend.
+func cacheKey(req *http.Request) string {
+	tokenHash := sha256.Sum256([]byte(
+		req.Header.Get("Client-ID") + " " + req.Header.Get("Authorization"),
+	))
+
+	return fmt.Sprintf("%s %s %s", req.Method, req.URL.String(), hex.EncodeToString(tokenHash[:]))
+}
@@ -0,0 +1,152 @@
+package helix
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/kvizyx/twitchkit/telemetry"
+)
+
+// recordingMetrics records endpoints of the requests and cache hits.
+type recordingMetrics struct {
+	telemetry.Nop
+
+	requests  []string
+	cacheHits []string
+	locker    sync.Mutex
+}
+
+func (m *recordingMetrics) RecordRequest(_ context.Context, record telemetry.RequestRecord) {
+	m.locker.Lock()
+	defer m.locker.Unlock()
+
+	m.requests = append(m.requests, record.Endpoint)
+}
+
+func (m *recordingMetrics) RecordCacheHit(_ context.Context, endpoint string) {
+	m.locker.Lock()
+	defer m.locker.Unlock()
+
+	m.cacheHits = append(m.cacheHits, endpoint)
+}
+
+func TestCacheHitMetrics(t *testing.T) {
+	httpClient := &fakeHTTPClient{responses: []fakeResponse{{status: http.StatusOK, body: `{"data":[]}`}}}
+	metrics := &recordingMetrics{}
+
+	client, err := NewClient(ClientConfig{
+		AuthProvider: &fakeAuthProvider{},
+		HTTPClient:   httpClient,
+		Metrics:      metrics,
+		Cache: &CacheConfig{
+			EndpointTTL: map[string]time.Duration{"chat/badges/global": time.Hour},
+		},
+	})
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	for range 3 {
+		_, err := Do[[]any](context.Background(), *client, RawRequest{
+			Method:   http.MethodGet,
+			Resource: "chat/badges/global",
+		})
+		if err != nil {
+			t.Fatalf("do: %v", err)
+		}
+	}
+
+	if sent := httpClient.requests(); len(sent) != 1 {
+		t.Fatalf("sent %d requests, want 1", len(sent))
+	}
+
+	if len(metrics.requests) != 1 {
+		t.Errorf("recorded requests = %v, want 1", metrics.requests)
+	}
+
+	if len(metrics.cacheHits) != 2 || metrics.cacheHits[0] != "chat/badges/global" {
+		t.Errorf("recorded cache hits = %v, want 2 of chat/badges/global", metrics.cacheHits)
+	}
+}
+
+func TestCacheRateLimitHeaders(t *testing.T) {
+	fresh := http.Header{}
+	fresh.Set("RateLimit-Remaining", "799")
+	fresh.Set("ETag", `"v1"`)
+
+	revalidated := http.Header{}
+	revalidated.Set("RateLimit-Remaining", "700")
+
+	httpClient := &fakeHTTPClient{responses: []fakeResponse{
+		{status: http.StatusOK, body: `{"data":[]}`, header: fresh},
+		{status: http.StatusNotModified, header: revalidated},
+	}}
+
+	backend := NewLRUCache(DefaultCacheSize)
+
+	client, err := NewClient(ClientConfig{
+		AuthProvider: &fakeAuthProvider{},
+		HTTPClient:   httpClient,
+		Cache: &CacheConfig{
+			Backend:     backend,
+			EndpointTTL: map[string]time.Duration{"chat/badges/global": time.Hour},
+		},
+	})
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	get := func() string {
+		t.Helper()
+
+		output, err := Do[[]any](context.Background(), *client, RawRequest{
+			Method:   http.MethodGet,
+			Resource: "chat/badges/global",
+		})
+		if err != nil {
+			t.Fatalf("do: %v", err)
+		}
+
+		return output.ResponseMetadata.Header.Get("RateLimit-Remaining")
+	}
+
+	if remaining := get(); remaining != "799" {
+		t.Errorf("response: rate-limit remaining = %q, want %q", remaining, "799")
+	}
+
+	if remaining := get(); len(remaining) != 0 {
+		t.Errorf("cache hit: rate-limit remaining = %q, want none", remaining)
+	}
+
+	// expire cached response, so it's revalidated.
+	key := func() string {
+		for _, request := range httpClient.requests() {
+			req, _ := http.NewRequest(http.MethodGet, request.url, nil)
+			req.Header.Set("Client-ID", "client-id")
+			req.Header.Set("Authorization", request.authorization)
+
+			return cacheKey(req)
+		}
+
+		return ""
+	}()
+
+	cached, found, _ := backend.Get(context.Background(), key)
+	if !found {
+		t.Fatal("response is not cached")
+	}
+
+	cached.ExpiresAt = time.Now().Add(-time.Second)
+	_ = backend.Set(context.Background(), key, cached)
+
+	if remaining := get(); remaining != "700" {
+		t.Errorf("revalidated: rate-limit remaining = %q, want %q", remaining, "700")
+	}
+
+	if sent := httpClient.requests(); len(sent) != 2 {
+		t.Errorf("sent %d requests, want 2", len(sent))
+	}
+}
@@ -0,0 +1,109 @@
+package helix
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// Cheer is a single cheer found in the chat message (e.g. "Cheer100").
+type Cheer struct {
+	// Token is the cheer as it's written in the message.
+	Token     string
+	Prefix    string
+	Amount    int
+	Cheermote Cheermote
+
+	// Tier is the highest tier of the cheermote that the amount reaches.
+	Tier CheermoteTier
+}
+
+// CheerParser finds cheers in the chat messages using the known cheermotes.
+type CheerParser struct {
+	cheermotes map[string]Cheermote
+}
+
+// NewCheerParser creates CheerParser for the cheermotes returned by
+// BitsResource.GetCheermotes.
+func NewCheerParser(cheermotes []Cheermote) CheerParser {
+	parser := CheerParser{
+		cheermotes: make(map[string]Cheermote, len(cheermotes)),
+	}
+
+	for _, cheermote := range cheermotes {
+		tiers := slices.Clone(cheermote.Tiers)
+		slices.SortFunc(tiers, func(a, b CheermoteTier) int {
+			return a.MinBits - b.MinBits
+		})
+
+		cheermote.Tiers = tiers
+		parser.cheermotes[strings.ToLower(cheermote.Prefix)] = cheermote
+	}
+
+	return parser
+}
+
+// Parse returns cheers found in the message in order of appearance. Cheer is a separate
+// word that consists of a known cheermote prefix (case-insensitive) followed by a
+// positive number of Bits.
+func (cp CheerParser) Parse(message string) []Cheer {
+	var cheers []Cheer
+
+	for _, word := range strings.Fields(message) {
+		cheer, ok := cp.parseWord(word)
+		if ok {
+			cheers = append(cheers, cheer)
+		}
+	}
+
+	return cheers
+}
+
+// Total returns the total amount of Bits cheered in the message.
+func (cp CheerParser) Total(message string) int {
+	var total int
+
+	for _, cheer := range cp.Parse(message) {
+		total += cheer.Amount
+	}
+
+	return total
+}
+
+func (cp CheerParser) parseWord(word string) (Cheer, bool) {
+	digitsFrom := strings.LastIndexFunc(word, func(r rune) bool {
+		return !unicode.IsDigit(r)
+	}) + 1
+
+	if digitsFrom == 0 || digitsFrom == len(word) {
+		return Cheer{}, false
+	}
+
+	cheermote, ok := cp.cheermotes[strings.ToLower(word[:digitsFrom])]
+	if !ok {
+		return Cheer{}, false
+	}
+
+	amount, err := strconv.Atoi(word[digitsFrom:])
+	if err != nil || amount <= 0 {
+		return Cheer{}, false
+	}
+
+	cheer := Cheer{
+		Token:     word,
+		Prefix:    cheermote.Prefix,
+		Amount:    amount,
+		Cheermote: cheermote,
+	}
+
+	for _, tier := range cheermote.Tiers {
+		if tier.MinBits > amount {
+			break
+		}
+
+		cheer.Tier = tier
+	}
+
+	return cheer, true
+}
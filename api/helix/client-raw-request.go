@@ -0,0 +1,73 @@
+package helix
+
+import (
+	"context"
+	"errors"
+	"net/url"
+
+	"github.com/kvizyx/twitchkit/api"
+	"github.com/kvizyx/twitchkit/http-core"
+)
+
+// Pagination contains cursor to get the next page of results.
+type Pagination struct {
+	Cursor string `json:"cursor"`
+}
+
+// Response is a common Helix response with data wrapper.
+type Response[T any] struct {
+	Data             T          `json:"data"`
+	Pagination       Pagination `json:"pagination"`
+	Total            int        `json:"total"`
+	ResponseMetadata api.ResponseMetadata
+}
+
+// RawRequest describes request to the arbitrary Helix endpoint.
+type RawRequest struct {
+	Method string
+
+	// Resource is a Helix resource without base URL (e.g. "chat/badges/global").
+	Resource string
+	Query    url.Values
+
+	// Body is encoded as JSON if it's not nil.
+	Body any
+
+	AuthParams RequestAuthParams
+}
+
+// Do does request to the arbitrary Helix endpoint and decodes data from the response
+// into T (empty data is returned for http.StatusNoContent responses). It's useful for
+// endpoints that are not wrapped by the client yet (e.g. beta endpoints). Access token
+// selection, refresh and retries work the same way as for the wrapped endpoints.
+func Do[T any](ctx context.Context, client Client, request RawRequest) (Response[T], error) {
+	var output Response[T]
+
+	metadata, err := DoRaw(ctx, client, request, &output)
+	output.ResponseMetadata = metadata
+
+	// endpoint has nothing to decode, so it's just empty data.
+	if errors.Is(err, httpcore.ErrNoContentDestination) {
+		return output, nil
+	}
+
+	return output, err
+}
+
+// DoRaw is like Do, but decodes the whole response body into dest, so it's suitable
+// for endpoints that do not wrap their response in data. Response body is ignored if
+// dest is nil.
+func DoRaw(ctx context.Context, client Client, request RawRequest, dest any) (api.ResponseMetadata, error) {
+	req, err := httpcore.NewAPIRequest(ctx, httpcore.RequestOptions{
+		APIType:   api.TypeHelix,
+		Resource:  request.Resource,
+		Method:    request.Method,
+		URLValues: request.Query,
+		Body:      request.Body,
+	}, true)
+	if err != nil {
+		return api.ResponseMetadata{}, err
+	}
+
+	return client.doRequest(req, dest, request.AuthParams)
+}
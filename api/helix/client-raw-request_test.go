@@ -0,0 +1,33 @@
+package helix
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func TestDoNoContentAfterRetry(t *testing.T) {
+	httpClient := &fakeHTTPClient{responses: []fakeResponse{
+		{status: http.StatusServiceUnavailable},
+		{status: http.StatusNoContent},
+	}}
+
+	client := newTestClient(t, httpClient, &fakeAuthProvider{})
+	client.retryConfig.RetryUnavailableTimes = RetryUntilOK
+
+	output, err := Do[[]struct{}](context.Background(), *client, RawRequest{
+		Method:   http.MethodDelete,
+		Resource: "moderation/chat",
+	})
+	if err != nil {
+		t.Fatalf("do: %v", err)
+	}
+
+	if output.ResponseMetadata.StatusCode != http.StatusNoContent {
+		t.Errorf("status code = %d, want %d", output.ResponseMetadata.StatusCode, http.StatusNoContent)
+	}
+
+	if sent := httpClient.requests(); len(sent) != 2 {
+		t.Errorf("sent %d requests, want 2", len(sent))
+	}
+}
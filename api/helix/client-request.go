@@ -5,12 +5,15 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/kvizyx/twitchkit/api"
 	"github.com/kvizyx/twitchkit/api/oauth"
 	"github.com/kvizyx/twitchkit/auth-provider"
 	"github.com/kvizyx/twitchkit/http-core"
+	"github.com/kvizyx/twitchkit/telemetry"
 )
 
 var (
@@ -18,24 +21,86 @@ var (
 	ErrAuthNoUserID = errors.New("no user ID was provided for authorized request")
 )
 
-// RequestAuthParams ...
+// RequestAuthParams describes which access token should be used for the request.
+//
+// If Scopes are set, the request is made with user access token of UserID that must
+// include all of them. Otherwise, user access token of UserID (or of the user set by
+// Client.AsUser) is used if provider has one, and app access token is used if not.
 type RequestAuthParams struct {
 	UserID string
 	Scopes []string
+
+	// AnyScopes are alternative scopes of the endpoints that accept either of them, so
+	// user access token must include at least one of them (along with all Scopes).
+	AnyScopes []string
+
+	// AppToken forces app access token for the endpoints that accept only it, even if
+	// user is set by Client.AsUser.
+	AppToken bool
+}
+
+// ModeratorAuthParams returns RequestAuthParams for endpoints that are called on behalf
+// of the broadcaster's moderator (they take moderator_id along with broadcaster_id). Such
+// requests must be made with user access token of the moderator, not of the broadcaster.
+// If moderatorID is empty, broadcaster acts as moderator of their own channel.
+//
+// The moderator ID to send in the request is returned along with the params.
+func ModeratorAuthParams(broadcasterID, moderatorID string, scopes ...string) (string, RequestAuthParams) {
+	if len(moderatorID) == 0 {
+		moderatorID = broadcasterID
+	}
+
+	return moderatorID, RequestAuthParams{
+		UserID: moderatorID,
+		Scopes: scopes,
+	}
 }
 
+// helixBasePath is a path prefix of every Helix resource URL.
+const helixBasePath = "/helix/"
+
 func (c Client) doRequest(req *http.Request, dest any, authParams RequestAuthParams) (api.ResponseMetadata, error) {
+	endpoint := endpointOf(req)
+
+	ctx, span := c.tracer.Start(req.Context(), "helix "+endpoint,
+		telemetry.String("http.method", req.Method),
+		telemetry.String("twitch.endpoint", endpoint),
+	)
+	defer span.End()
+
+	metadata, err := c.doRequestWithAuth(req.WithContext(ctx), dest, authParams)
+	if metadata.StatusCode != 0 {
+		span.SetAttributes(telemetry.Int("http.status_code", metadata.StatusCode))
+	}
+
+	if err != nil {
+		span.RecordError(err)
+	}
+
+	return metadata, err
+}
+
+func (c Client) doRequestWithAuth(
+	req *http.Request,
+	dest any,
+	authParams RequestAuthParams,
+) (api.ResponseMetadata, error) {
+	if authParams.AppToken {
+		appToken, err := c.authProvider.AppAccessToken(req.Context(), false)
+		if err != nil {
+			return api.ResponseMetadata{}, fmt.Errorf("get app access token: %w", err)
+		}
+
+		return c.doAuthorizedRequest(req, dest, &appToken, "")
+	}
+
 	// specified scopes means that we are forced to do request with user access token.
-	if len(authParams.Scopes) != 0 {
+	if len(authParams.Scopes) != 0 || len(authParams.AnyScopes) != 0 {
 		if len(authParams.UserID) == 0 {
 			return api.ResponseMetadata{}, ErrAuthNoUserID
 		}
 
-		userToken, err := c.authProvider.UserAccessToken(
-			req.Context(),
-			authParams.UserID,
-			authParams.Scopes,
-		)
+		userToken, err := c.userAccessToken(req.Context(), authParams)
 		if err != nil {
 			return api.ResponseMetadata{}, fmt.Errorf("get user access token: %w", err)
 		}
@@ -77,6 +142,25 @@ func (c Client) doRequest(req *http.Request, dest any, authParams RequestAuthPar
 	return c.doAuthorizedRequest(req, dest, accessToken, ctxUserID)
 }
 
+// userAccessToken gets user access token with the scopes of the params. Alternatives of
+// AnyScopes are tried in order until the token includes one of them.
+func (c Client) userAccessToken(ctx context.Context, authParams RequestAuthParams) (oauth.UserAccessToken, error) {
+	if len(authParams.AnyScopes) == 0 {
+		return c.authProvider.UserAccessToken(ctx, authParams.UserID, authParams.Scopes)
+	}
+
+	for _, scope := range authParams.AnyScopes {
+		scopes := append(slices.Clip(authParams.Scopes), scope)
+
+		userToken, err := c.authProvider.UserAccessToken(ctx, authParams.UserID, scopes)
+		if !errors.Is(err, oauth.ErrMissingScope) {
+			return userToken, err
+		}
+	}
+
+	return oauth.UserAccessToken{}, oauth.MissingScopeError(strings.Join(authParams.AnyScopes, " or "))
+}
+
 func (c Client) doAuthorizedRequest(
 	req *http.Request,
 	dest any,
@@ -88,7 +172,7 @@ func (c Client) doAuthorizedRequest(
 	api.SetClientHeader(req, c.authProvider.ClientID())
 	api.SetAuthHeader(req, authType, accessToken.AccessToken())
 
-	metadata, err := httpcore.DoAPIRequest(req, dest, c.httpClient)
+	metadata, err := c.sendRequest(req, dest)
 	if err != nil {
 		if !errors.Is(err, httpcore.ErrUnsuccessfulRequest) {
 			return metadata, err
@@ -97,6 +181,10 @@ func (c Client) doAuthorizedRequest(
 
 	switch metadata.StatusCode {
 	case http.StatusUnauthorized:
+		if isUnverifiedPhone(metadata) {
+			break
+		}
+
 		if len(accessToken.RefreshToken()) == 0 {
 			appToken, err := c.authProvider.AppAccessToken(req.Context(), true)
 			if err != nil {
@@ -105,7 +193,7 @@ func (c Client) doAuthorizedRequest(
 
 			api.SetAuthHeader(req, authType, appToken.AccessToken())
 
-			return c.retryRequest(req, dest, 1, 0, 0)
+			return c.retryRequest(req, dest, telemetry.RetryReasonUnauthorized, 1, 0, 0)
 		}
 
 		freshToken, err := c.tryRefreshUserAccessToken(req.Context(), userID)
@@ -115,7 +203,7 @@ func (c Client) doAuthorizedRequest(
 
 		api.SetAuthHeader(req, authType, freshToken.AccessToken())
 
-		return c.retryRequest(req, dest, 1, 0, 0)
+		return c.retryRequest(req, dest, telemetry.RetryReasonUnauthorized, 1, 0, 0)
 	case http.StatusTooManyRequests:
 		if c.retryConfig.RetryRateLimit {
 			return c.retryRateLimitedRequest(req, metadata, dest)
@@ -125,6 +213,7 @@ func (c Client) doAuthorizedRequest(
 			return c.retryRequest(
 				req,
 				dest,
+				telemetry.RetryReasonUnavailable,
 				c.retryConfig.RetryUnavailableTimes,
 				0,
 				c.retryConfig.RetryUnavailableInterval,
@@ -135,6 +224,45 @@ func (c Client) doAuthorizedRequest(
 	return metadata, err
 }
 
+// sendRequest sends a fresh copy of the request, so the original one stays untouched
+// and can be sent again with its body intact and updated headers.
+func (c Client) sendRequest(req *http.Request, dest any) (api.ResponseMetadata, error) {
+	attempt, err := httpcore.CloneRequest(req)
+	if err != nil {
+		return api.ResponseMetadata{}, fmt.Errorf("clone request: %w", err)
+	}
+
+	attempt, cacheHit := withCacheHitFlag(attempt)
+
+	start := time.Now()
+
+	metadata, err := httpcore.DoAPIRequest(attempt, dest, c.httpClient)
+
+	if *cacheHit {
+		c.metrics.RecordCacheHit(req.Context(), endpointOf(req))
+		return metadata, err
+	}
+
+	c.metrics.RecordRequest(req.Context(), telemetry.RequestRecord{
+		Endpoint:   endpointOf(req),
+		Method:     req.Method,
+		StatusCode: metadata.StatusCode,
+		Duration:   time.Since(start),
+		Err:        err,
+	})
+
+	if len(metadata.Header.Get("RateLimit-Remaining")) != 0 {
+		c.metrics.RecordRateLimitRemaining(req.Context(), metadata.RateLimitRemaining())
+	}
+
+	return metadata, err
+}
+
+// endpointOf returns Helix resource the request is made to.
+func endpointOf(req *http.Request) string {
+	return strings.TrimPrefix(req.URL.Path, helixBasePath)
+}
+
 func (c Client) tryRefreshUserAccessToken(
 	ctx context.Context,
 	userID string,
@@ -168,7 +296,7 @@ func (c Client) retryRateLimitedRequest(
 
 	retryAfter := (time.Duration(serverLimitTimeout)) * time.Second
 
-	return c.retryRequest(req, dest, 1, retryAfter, 0)
+	return c.retryRequest(req, dest, telemetry.RetryReasonRateLimit, 1, retryAfter, 0)
 }
 
 // retryRequest stupidly retry request with given options. No exp. backoff or
@@ -176,6 +304,7 @@ func (c Client) retryRateLimitedRequest(
 func (c Client) retryRequest(
 	req *http.Request,
 	dest any,
+	reason telemetry.RetryReason,
 	times int32,
 	after, interval time.Duration,
 ) (api.ResponseMetadata, error) {
@@ -195,12 +324,22 @@ func (c Client) retryRequest(
 		metadata api.ResponseMetadata
 	)
 
+	endpoint := endpointOf(req)
+
 	for {
-		metadata, err = httpcore.DoAPIRequest(req, dest, c.httpClient)
+		c.metrics.RecordRetry(req.Context(), endpoint, reason)
+
+		metadata, err = c.sendRequest(req, dest)
 		if err == nil {
 			return metadata, nil
 		}
 
+		// request succeeded, but its response can't be handled (e.g. 204 for non-nil
+		// dest), so sending it again won't change anything.
+		if metadata.StatusCode >= http.StatusOK && metadata.StatusCode < http.StatusMultipleChoices {
+			return metadata, err
+		}
+
 		retried += 1
 		if retried == times && times != RetryUntilOK {
 			break
@@ -0,0 +1,143 @@
+package helix
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/kvizyx/twitchkit/api"
+	"github.com/kvizyx/twitchkit/http-core"
+)
+
+func TestDoRequestReplaysBodyOnRetry(t *testing.T) {
+	const body = `{"title":"retry me"}`
+
+	twitchError := func(status int) fakeResponse {
+		return fakeResponse{status: status, body: `{"error":"error","status":0,"message":"error"}`}
+	}
+
+	ok := fakeResponse{status: http.StatusOK, body: `{"data":[]}`}
+
+	tests := []struct {
+		name      string
+		method    string
+		responses []fakeResponse
+		wantSent  int
+		refreshes int
+	}{
+		{
+			name:      "401 refresh POST",
+			method:    http.MethodPost,
+			responses: []fakeResponse{twitchError(http.StatusUnauthorized), ok},
+			wantSent:  2,
+			refreshes: 1,
+		},
+		{
+			name:      "429 rate limit PATCH",
+			method:    http.MethodPatch,
+			responses: []fakeResponse{twitchError(http.StatusTooManyRequests), ok},
+			wantSent:  2,
+		},
+		{
+			name:   "503 unavailable POST",
+			method: http.MethodPost,
+			responses: []fakeResponse{
+				{status: http.StatusServiceUnavailable},
+				{status: http.StatusServiceUnavailable},
+				ok,
+			},
+			wantSent: 3,
+		},
+		{
+			name:   "503 unavailable PATCH",
+			method: http.MethodPatch,
+			responses: []fakeResponse{
+				{status: http.StatusServiceUnavailable},
+				ok,
+			},
+			wantSent: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			httpClient := &fakeHTTPClient{responses: tt.responses}
+			provider := &fakeAuthProvider{}
+			client := newTestClient(t, httpClient, provider)
+
+			req, err := httpcore.NewAPIRequest(context.Background(), httpcore.RequestOptions{
+				APIType:  api.TypeHelix,
+				Resource: "channels",
+				Method:   tt.method,
+				Body:     map[string]string{"title": "retry me"},
+			}, true)
+			if err != nil {
+				t.Fatalf("new request: %v", err)
+			}
+
+			var dest struct {
+				Data []any `json:"data"`
+			}
+
+			metadata, err := client.doRequest(req, &dest, RequestAuthParams{UserID: "1", Scopes: []string{"scope"}})
+			if err != nil {
+				t.Fatalf("do request: %v", err)
+			}
+
+			if metadata.StatusCode != http.StatusOK {
+				t.Errorf("status code = %d, want %d", metadata.StatusCode, http.StatusOK)
+			}
+
+			sent := httpClient.requests()
+			if len(sent) != tt.wantSent {
+				t.Fatalf("sent %d requests, want %d", len(sent), tt.wantSent)
+			}
+
+			for i, request := range sent {
+				if request.method != tt.method {
+					t.Errorf("request %d: method = %s, want %s", i, request.method, tt.method)
+				}
+
+				if request.body != body {
+					t.Errorf("request %d: body = %q, want %q", i, request.body, body)
+				}
+			}
+
+			if provider.refreshes != tt.refreshes {
+				t.Errorf("refreshes = %d, want %d", provider.refreshes, tt.refreshes)
+			}
+
+			if tt.refreshes != 0 && sent[len(sent)-1].authorization != "Bearer refreshed-token" {
+				t.Errorf("retry authorization = %q, want refreshed token", sent[len(sent)-1].authorization)
+			}
+		})
+	}
+}
+
+func TestDoRequestRejectsNotReplayableBody(t *testing.T) {
+	httpClient := &fakeHTTPClient{responses: []fakeResponse{{status: http.StatusOK, body: `{}`}}}
+	client := newTestClient(t, httpClient, &fakeAuthProvider{})
+
+	// body that is not one of the standard readers leaves GetBody nil.
+	req, err := http.NewRequestWithContext(
+		context.Background(),
+		http.MethodPost,
+		api.ComposeHelixURL("channels"),
+		io.NopCloser(strings.NewReader(`{}`)),
+	)
+	if err != nil {
+		t.Fatalf("new request: %v", err)
+	}
+
+	_, err = client.doRequest(req, nil, RequestAuthParams{})
+	if !errors.Is(err, httpcore.ErrBodyNotReplayable) {
+		t.Fatalf("err = %v, want %v", err, httpcore.ErrBodyNotReplayable)
+	}
+
+	if sent := httpClient.requests(); len(sent) != 0 {
+		t.Errorf("sent %d requests, want 0", len(sent))
+	}
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/kvizyx/twitchkit/auth-provider"
 	"github.com/kvizyx/twitchkit/http-core"
+	"github.com/kvizyx/twitchkit/telemetry"
 )
 
 // UserContext ...
@@ -18,12 +19,39 @@ type (
 		httpClient   httpcore.HTTPClient
 		userCtx      UserContext
 		retryConfig  RetryConfig
+		tracer       telemetry.Tracer
+		metrics      telemetry.Metrics
+
+		// baseHTTPClient is HTTPClient without middlewares and cache, it's used for
+		// requests outside of Twitch API (e.g. analytics report downloads).
+		baseHTTPClient httpcore.HTTPClient
+
+		whisperLimiter *WhisperLimiter
 	}
 
 	ClientConfig struct {
 		AuthProvider authprovider.AuthProvider
 		HTTPClient   httpcore.HTTPClient
 		RetryConfig  RetryConfig
+
+		// Middlewares are wrapped around HTTPClient in the given order, so they are
+		// able to observe every request made by the client, including retries.
+		Middlewares []httpcore.Middleware
+
+		// Tracer and Metrics are optional telemetry hooks for requests, retries and
+		// rate-limits. Nothing is recorded if they are not set.
+		Tracer  telemetry.Tracer
+		Metrics telemetry.Metrics
+
+		// Cache enables response cache for read-only endpoints with rarely changing
+		// data. Responses are not cached if it's nil.
+		Cache *CacheConfig
+
+		// WhisperLimiter keeps whispers within Twitch limits. Share the same limiter
+		// between clients that send whispers on behalf of the same users.
+		//
+		// By default, every client has its own limiter.
+		WhisperLimiter *WhisperLimiter
 	}
 )
 
@@ -36,10 +64,24 @@ func NewClient(cfg ClientConfig) (*Client, error) {
 		cfg.HTTPClient = httpcore.DefaultHTTPClient()
 	}
 
+	if cfg.WhisperLimiter == nil {
+		cfg.WhisperLimiter = NewWhisperLimiter()
+	}
+
+	httpClient := httpcore.Chain(cfg.HTTPClient, cfg.Middlewares...)
+	if cfg.Cache != nil {
+		httpClient = newCachingHTTPClient(httpClient, *cfg.Cache)
+	}
+
 	return &Client{
 		authProvider: cfg.AuthProvider,
-		httpClient:   cfg.HTTPClient,
+		httpClient:   httpClient,
 		retryConfig:  finalizeRetryConfig(cfg.RetryConfig),
+		tracer:       telemetry.TracerOrNop(cfg.Tracer),
+		metrics:      telemetry.MetricsOrNop(cfg.Metrics),
+
+		baseHTTPClient: cfg.HTTPClient,
+		whisperLimiter: cfg.WhisperLimiter,
 	}, nil
 }
 
@@ -0,0 +1,120 @@
+package helix
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+type (
+	ReconcileDropsInput struct {
+		// UserID and GameID limit reconciliation to entitlements of the user or the game.
+		UserID string
+		GameID string
+	}
+
+	ReconcileDropsOutput struct {
+		// Updated groups IDs of the entitlements by status of their update to FULFILLED.
+		// Only SUCCESS entitlements are fulfilled, the rest should be looked into (e.g.
+		// UPDATE_FAILED ones can be retried).
+		Updated map[EntitlementUpdateStatus][]string
+
+		// NotGranted contains IDs of the entitlements that grant callback failed for.
+		// They are left CLAIMED, so the next reconciliation picks them up again.
+		NotGranted []string
+
+		// GrantedNotFulfilled contains IDs of the entitlements that were granted, but
+		// the request to mark them FULFILLED failed. They are left CLAIMED and will be
+		// passed to grant again by the next reconciliation.
+		GrantedNotFulfilled []string
+	}
+)
+
+// ReconcileDrops fulfills all CLAIMED Drops entitlements. Entitlements are passed to
+// grant in batches (up to 100 entitlements), which must give the rewards to the players,
+// and every batch it succeeds for is marked FULFILLED on Twitch. Batch that grant or
+// update failed for stays CLAIMED and doesn't stop the reconciliation, but its error is
+// returned (joined with the others) along with the output.
+//
+// Since rewards are granted before entitlements are marked FULFILLED, grant may see the
+// same entitlement again if the previous update failed, so it should be idempotent.
+func (r EntitlementsResource) ReconcileDrops(
+	ctx context.Context,
+	input ReconcileDropsInput,
+	grant func(ctx context.Context, entitlements []DropEntitlement) error,
+) (ReconcileDropsOutput, error) {
+	output := ReconcileDropsOutput{
+		Updated: make(map[EntitlementUpdateStatus][]string),
+	}
+
+	// all claimed entitlements are collected first, as fulfilling them while paginating
+	// would shift the pages.
+	claimed, err := r.claimedEntitlements(ctx, input)
+	if err != nil {
+		return output, err
+	}
+
+	var errs []error
+
+	for start := 0; start < len(claimed); start += maxEntitlementUpdatesPerRequest {
+		batch := claimed[start:min(start+maxEntitlementUpdatesPerRequest, len(claimed))]
+
+		ids := make([]string, 0, len(batch))
+		for _, entitlement := range batch {
+			ids = append(ids, entitlement.ID)
+		}
+
+		if err = grant(ctx, batch); err != nil {
+			output.NotGranted = append(output.NotGranted, ids...)
+			errs = append(errs, fmt.Errorf("grant entitlements: %w", err))
+
+			continue
+		}
+
+		updated, err := r.UpdateDropsEntitlements(ctx, UpdateDropsEntitlementsInput{
+			EntitlementIDs:    ids,
+			FulfillmentStatus: FulfillmentStatusFulfilled,
+		})
+		if err != nil {
+			output.GrantedNotFulfilled = append(output.GrantedNotFulfilled, ids...)
+			errs = append(errs, fmt.Errorf("update drops entitlements: %w", err))
+
+			continue
+		}
+
+		for _, update := range updated.Updates {
+			output.Updated[update.Status] = append(output.Updated[update.Status], update.IDs...)
+		}
+	}
+
+	return output, errors.Join(errs...)
+}
+
+func (r EntitlementsResource) claimedEntitlements(
+	ctx context.Context,
+	input ReconcileDropsInput,
+) ([]DropEntitlement, error) {
+	request := GetDropsEntitlementsInput{
+		UserID:            input.UserID,
+		GameID:            input.GameID,
+		FulfillmentStatus: FulfillmentStatusClaimed,
+		First:             maxEntitlementsPerPage,
+	}
+
+	var claimed []DropEntitlement
+
+	for {
+		output, err := r.GetDropsEntitlements(ctx, request)
+		if err != nil {
+			return nil, fmt.Errorf("get drops entitlements: %w", err)
+		}
+
+		claimed = append(claimed, output.Entitlements...)
+
+		if len(output.Pagination.Cursor) == 0 || len(output.Entitlements) == 0 {
+			return claimed, nil
+		}
+
+		request.After = output.Pagination.Cursor
+	}
+}
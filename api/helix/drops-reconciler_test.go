@@ -0,0 +1,113 @@
+package helix
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestReconcileDrops(t *testing.T) {
+	entitlements := make([]map[string]string, 0, 250)
+	for i := range 250 {
+		entitlements = append(entitlements, map[string]string{
+			"id":                 fmt.Sprintf("e%d", i),
+			"fulfillment_status": string(FulfillmentStatusClaimed),
+		})
+	}
+
+	claimed, err := json.Marshal(map[string]any{"data": entitlements})
+	if err != nil {
+		t.Fatalf("marshal entitlements: %v", err)
+	}
+
+	// the last batch is e200-e249, and its entitlements get every update status.
+	ids := func(from, to int) []string {
+		var ids []string
+		for i := from; i < to; i++ {
+			ids = append(ids, fmt.Sprintf("e%d", i))
+		}
+
+		return ids
+	}
+
+	updates := []EntitlementUpdate{
+		{Status: EntitlementUpdateSuccess, IDs: ids(200, 240)},
+		{Status: EntitlementUpdateInvalidID, IDs: ids(240, 242)},
+		{Status: EntitlementUpdateNotFound, IDs: ids(242, 244)},
+		{Status: EntitlementUpdateUnauthorized, IDs: ids(244, 246)},
+		{Status: EntitlementUpdateFailed, IDs: ids(246, 250)},
+	}
+
+	updated, err := json.Marshal(map[string]any{"data": updates})
+	if err != nil {
+		t.Fatalf("marshal updates: %v", err)
+	}
+
+	httpClient := &fakeHTTPClient{responses: []fakeResponse{
+		{status: http.StatusOK, body: string(claimed)},
+		// update of the second batch fails.
+		{status: http.StatusInternalServerError},
+		{status: http.StatusOK, body: string(updated)},
+	}}
+
+	client := newTestClient(t, httpClient, &fakeAuthProvider{})
+
+	var granted []string
+
+	errGrant := errors.New("inventory is unavailable")
+
+	output, err := client.Entitlements().ReconcileDrops(
+		context.Background(),
+		ReconcileDropsInput{GameID: "1"},
+		func(_ context.Context, batch []DropEntitlement) error {
+			// grant of the first batch fails.
+			if batch[0].ID == "e0" {
+				return errGrant
+			}
+
+			for _, entitlement := range batch {
+				granted = append(granted, entitlement.ID)
+			}
+
+			return nil
+		},
+	)
+
+	if !errors.Is(err, errGrant) || !strings.Contains(err.Error(), "update drops entitlements") {
+		t.Errorf("err = %v, want grant and update errors", err)
+	}
+
+	if !slices.Equal(output.NotGranted, ids(0, 100)) {
+		t.Errorf("not granted = %v, want e0-e99", output.NotGranted)
+	}
+
+	if !slices.Equal(output.GrantedNotFulfilled, ids(100, 200)) {
+		t.Errorf("granted not fulfilled = %v, want e100-e199", output.GrantedNotFulfilled)
+	}
+
+	if !slices.Equal(granted, ids(100, 250)) {
+		t.Errorf("granted = %v, want e100-e249", granted)
+	}
+
+	for _, update := range updates {
+		if got := output.Updated[update.Status]; !slices.Equal(got, update.IDs) {
+			t.Errorf("updated %s = %v, want %v", update.Status, got, update.IDs)
+		}
+	}
+
+	sent := httpClient.requests()
+	if len(sent) != 3 {
+		t.Fatalf("sent %d requests, want 3", len(sent))
+	}
+
+	for i, method := range []string{http.MethodGet, http.MethodPatch, http.MethodPatch} {
+		if sent[i].method != method {
+			t.Errorf("request %d: method = %s, want %s", i, sent[i].method, method)
+		}
+	}
+}
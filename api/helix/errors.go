@@ -0,0 +1,92 @@
+package helix
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+var (
+	ErrTooManyItems    = errors.New("too many items in the request")
+	ErrMissingInput    = errors.New("required input is missing")
+	ErrMessageDropped  = errors.New("chat message was dropped")
+	ErrNotFound        = errors.New("requested item is not found")
+	ErrInvalidInterval = errors.New("interval must be positive")
+
+	ErrUnverifiedPhone   = errors.New("user must have a verified phone number to send whispers")
+	ErrWhisperNotAllowed = errors.New("whisper is not allowed")
+	ErrWhisperLimit      = errors.New("whisper limit is exceeded")
+
+	ErrCommercialCooldown = errors.New("commercial can't be started yet")
+	ErrCurrencyMismatch   = errors.New("amounts are in different currencies")
+
+	ErrInvalidICalendar         = errors.New("invalid iCalendar data")
+	ErrUnsupportedCalendarEvent = errors.New("calendar event can't be converted to schedule segment")
+
+	// ErrRewardNotManageable is returned when custom reward (or its redemptions) is
+	// modified by the client that didn't create the reward.
+	ErrRewardNotManageable = errors.New("custom reward can be modified only by the client that created it")
+)
+
+// TooManyItemsError ...
+func TooManyItemsError(field string, limit int) error {
+	return fmt.Errorf("%w: %s accepts up to %d items", ErrTooManyItems, field, limit)
+}
+
+// MissingInputError ...
+func MissingInputError(field string) error {
+	return fmt.Errorf("%w (%s)", ErrMissingInput, field)
+}
+
+// InvalidIntervalError ...
+func InvalidIntervalError(interval time.Duration) error {
+	return fmt.Errorf("%w, got %s", ErrInvalidInterval, interval)
+}
+
+// MessageDroppedError ...
+func MessageDroppedError(reason *DropReason) error {
+	if reason == nil {
+		return ErrMessageDropped
+	}
+
+	return fmt.Errorf("%w: %s (%s)", ErrMessageDropped, reason.Message, reason.Code)
+}
+
+// RewardNotManageableError ...
+func RewardNotManageableError(message string) error {
+	if len(message) == 0 {
+		return ErrRewardNotManageable
+	}
+
+	return fmt.Errorf("%w: %s", ErrRewardNotManageable, message)
+}
+
+// UnverifiedPhoneError ...
+func UnverifiedPhoneError(message string) error {
+	return fmt.Errorf("%w: %s", ErrUnverifiedPhone, message)
+}
+
+// WhisperNotAllowedError ...
+func WhisperNotAllowedError(message string) error {
+	return fmt.Errorf("%w: %s", ErrWhisperNotAllowed, message)
+}
+
+// WhisperLimitError ...
+func WhisperLimitError(message string) error {
+	return fmt.Errorf("%w: %s", ErrWhisperLimit, message)
+}
+
+// InvalidICalendarError ...
+func InvalidICalendarError(line int, message string) error {
+	return fmt.Errorf("%w: line %d: %s", ErrInvalidICalendar, line, message)
+}
+
+// UnsupportedCalendarEventError ...
+func UnsupportedCalendarEventError(uid, message string) error {
+	return fmt.Errorf("%w: event %q: %s", ErrUnsupportedCalendarEvent, uid, message)
+}
+
+// CommercialCooldownError ...
+func CommercialCooldownError(readyAt time.Time) error {
+	return fmt.Errorf("%w: cooldown ends at %s", ErrCommercialCooldown, readyAt.Format(time.RFC3339))
+}
@@ -0,0 +1,238 @@
+package helix
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+const (
+	// gameResolverCandidates is a number of search results the best match is chosen from.
+	gameResolverCandidates = 20
+
+	// minGameMatchScore is a minimum similarity of the game name to the query for the
+	// game to be considered a match.
+	minGameMatchScore = 0.5
+
+	// minGamePartialQuery is a minimum length of the normalized query that is matched as
+	// a part or an acronym of the game name, shorter queries are parts of too many names.
+	minGamePartialQuery = 3
+)
+
+// GameResolver maps free-text game names (e.g. typed by chat moderators) to the games
+// and categories. Resolved games are cached, since their IDs never change.
+type GameResolver struct {
+	games  GamesResource
+	search SearchResource
+
+	// cache holds JSON of the resolved games, so queries typed by users can't grow it
+	// without limit.
+	cache *LRUCache
+
+	aliases map[string]Game
+	locker  sync.RWMutex
+}
+
+// NewGameResolver creates GameResolver that caches up to size resolved queries.
+// DefaultCacheSize is used if size is not positive.
+func (r GamesResource) NewGameResolver(size int) *GameResolver {
+	return &GameResolver{
+		games:   r,
+		search:  r.client.Search(),
+		cache:   NewLRUCache(size),
+		aliases: make(map[string]Game),
+	}
+}
+
+// Set makes the query always resolve to the game. It's useful for aliases that don't
+// resemble the game name (e.g. "lol" for League of Legends). Aliases are not evicted
+// from the cache.
+func (gr *GameResolver) Set(query string, game Game) {
+	gr.locker.Lock()
+	defer gr.locker.Unlock()
+
+	gr.aliases[normalizeGameName(query)] = game
+}
+
+// Resolve returns the game that matches the query best. Game with exactly the same name
+// is preferred, otherwise the categories search results are ranked by similarity to the
+// query. ErrNotFound is returned if none of them is similar enough.
+func (gr *GameResolver) Resolve(ctx context.Context, query string) (Game, error) {
+	key := normalizeGameName(query)
+	if len(key) == 0 {
+		return Game{}, MissingInputError("query")
+	}
+
+	if game, ok := gr.cached(ctx, key); ok {
+		return game, nil
+	}
+
+	game, err := gr.resolve(ctx, strings.TrimSpace(query), key)
+	if err != nil {
+		return Game{}, err
+	}
+
+	body, err := json.Marshal(game)
+	if err != nil {
+		return Game{}, err
+	}
+
+	// LRUCache never fails, so errors are not checked.
+	_ = gr.cache.Set(ctx, key, CachedResponse{Body: body})
+	_ = gr.cache.Set(ctx, normalizeGameName(game.Name), CachedResponse{Body: body})
+
+	return game, nil
+}
+
+func (gr *GameResolver) cached(ctx context.Context, key string) (Game, bool) {
+	gr.locker.RLock()
+	game, ok := gr.aliases[key]
+	gr.locker.RUnlock()
+
+	if ok {
+		return game, true
+	}
+
+	cached, ok, _ := gr.cache.Get(ctx, key)
+	if !ok {
+		return Game{}, false
+	}
+
+	if err := json.Unmarshal(cached.Body, &game); err != nil {
+		return Game{}, false
+	}
+
+	return game, true
+}
+
+func (gr *GameResolver) resolve(ctx context.Context, query, key string) (Game, error) {
+	exact, err := gr.games.GetGames(ctx, GetGamesInput{Names: []string{query}})
+	if err != nil {
+		return Game{}, fmt.Errorf("get games: %w", err)
+	}
+
+	if len(exact.Games) != 0 {
+		return exact.Games[0], nil
+	}
+
+	found, err := gr.search.SearchCategories(ctx, SearchCategoriesInput{
+		Query: query,
+		First: gameResolverCandidates,
+	})
+	if err != nil {
+		return Game{}, fmt.Errorf("search categories: %w", err)
+	}
+
+	var (
+		best      Game
+		bestScore float64
+	)
+
+	// candidates are ordered by relevance, so the first one wins the tie.
+	for _, candidate := range found.Categories {
+		score := gameMatchScore(key, candidate.Name)
+		if score > bestScore {
+			best, bestScore = candidate, score
+		}
+	}
+
+	if bestScore < minGameMatchScore {
+		return Game{}, fmt.Errorf("%w: no game matches %q", ErrNotFound, query)
+	}
+
+	return best, nil
+}
+
+// gameMatchScore returns similarity of the game name to the normalized query from 0 to 1.
+func gameMatchScore(key, name string) float64 {
+	normalized := normalizeGameName(name)
+	if len(normalized) == 0 {
+		return 0
+	}
+
+	if normalized == key {
+		return 1
+	}
+
+	var (
+		queryRunes = []rune(key)
+		nameRunes  = []rune(normalized)
+		longest    = max(len(queryRunes), len(nameRunes))
+	)
+
+	score := 1 - float64(levenshtein(queryRunes, nameRunes))/float64(longest)
+
+	if len(queryRunes) < minGamePartialQuery {
+		return score
+	}
+
+	// query is a part of the name (e.g. "minecraft" for "Minecraft Dungeons").
+	if strings.Contains(normalized, key) {
+		score = max(score, 0.6+0.4*float64(len(queryRunes))/float64(len(nameRunes)))
+	}
+
+	// query is an acronym of the name (e.g. "gtav" for "Grand Theft Auto V").
+	if gameNameInitials(name) == key {
+		score = max(score, 0.8)
+	}
+
+	return score
+}
+
+// normalizeGameName lowercases the name and drops everything except letters and digits.
+func normalizeGameName(name string) string {
+	var normalized strings.Builder
+
+	for _, r := range strings.ToLower(name) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			normalized.WriteRune(r)
+		}
+	}
+
+	return normalized.String()
+}
+
+// gameNameInitials returns first letters (or digits) of the name words in lower case.
+func gameNameInitials(name string) string {
+	words := strings.FieldsFunc(strings.ToLower(name), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	var initials strings.Builder
+
+	for _, word := range words {
+		initials.WriteRune([]rune(word)[0])
+	}
+
+	return initials.String()
+}
+
+// levenshtein returns edit distance between a and b.
+func levenshtein(a, b []rune) int {
+	previous := make([]int, len(b)+1)
+	current := make([]int, len(b)+1)
+
+	for j := range previous {
+		previous[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		current[0] = i
+
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+
+		previous, current = current, previous
+	}
+
+	return previous[len(b)]
+}
@@ -0,0 +1,132 @@
+package helix
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"testing"
+)
+
+func TestGameResolverCache(t *testing.T) {
+	httpClient := &fakeHTTPClient{responses: []fakeResponse{{
+		status: http.StatusOK,
+		body:   `{"data":[{"id":"27471","name":"Minecraft"}]}`,
+	}}}
+
+	client := newTestClient(t, httpClient, &fakeAuthProvider{})
+	resolver := client.Games().NewGameResolver(1)
+
+	resolve := func(query string) {
+		t.Helper()
+
+		game, err := resolver.Resolve(context.Background(), query)
+		if err != nil {
+			t.Fatalf("resolve %q: %v", query, err)
+		}
+
+		if game.ID != "27471" {
+			t.Fatalf("resolve %q: game = %+v", query, game)
+		}
+	}
+
+	resolve("Minecraft")
+	resolve("minecraft ")
+
+	if sent := httpClient.requests(); len(sent) != 1 {
+		t.Fatalf("sent %d requests, want 1", len(sent))
+	}
+
+	for _, query := range []string{"Minecraft Java", "Minecraft Bedrock", "mc"} {
+		resolve(query)
+	}
+
+	if size := resolver.cache.Len(); size != 1 {
+		t.Errorf("cache size = %d, want 1", size)
+	}
+
+	// aliases are kept regardless of the cache size.
+	resolver.Set("mcraft", Game{ID: "27471", Name: "Minecraft"})
+	resolve("Minecraft Dungeons")
+	resolve("mcraft")
+
+	if sent := httpClient.requests(); len(sent) != 5 {
+		t.Errorf("sent %d requests, want 5", len(sent))
+	}
+}
+
+func TestGameMatchScore(t *testing.T) {
+	tests := []struct {
+		name    string
+		key     string
+		game    string
+		want    float64
+		noMatch bool
+	}{
+		{name: "same name", key: "minecraft", game: "Minecraft", want: 1},
+		{name: "same normalized name", key: "stalker2", game: "S.T.A.L.K.E.R. 2", want: 1},
+		{name: "part of name", key: "minecraft", game: "Minecraft Dungeons", want: 0.6 + 0.4*9/17},
+		{name: "short part of name", key: "ark", game: "Arknights", want: 0.6 + 0.4*3/9},
+		{name: "acronym", key: "gtav", game: "Grand Theft Auto V", want: 0.8},
+		{name: "typo", key: "minecarft", game: "Minecraft", want: 1 - 2.0/9},
+		{name: "too short part of name", key: "ar", game: "Arknights", noMatch: true},
+		{name: "too short acronym", key: "gt", game: "Grand Theft", noMatch: true},
+		{name: "unrelated name", key: "fortnite", game: "Minecraft", noMatch: true},
+		{name: "name without letters", key: "minecraft", game: "???", want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := gameMatchScore(tt.key, tt.game)
+
+			if tt.noMatch {
+				if got >= minGameMatchScore {
+					t.Errorf("gameMatchScore(%q, %q) = %f, want less than %f", tt.key, tt.game, got, minGameMatchScore)
+				}
+
+				return
+			}
+
+			if math.Abs(got-tt.want) > 1e-9 {
+				t.Errorf("gameMatchScore(%q, %q) = %f, want %f", tt.key, tt.game, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNormalizeGameName(t *testing.T) {
+	tests := []struct {
+		name string
+		want string
+	}{
+		{name: "Minecraft", want: "minecraft"},
+		{name: "  Grand Theft Auto V ", want: "grandtheftautov"},
+		{name: "S.T.A.L.K.E.R. 2", want: "stalker2"},
+		{name: "Pokémon Red/Blue", want: "pokémonredblue"},
+		{name: "!?", want: ""},
+	}
+
+	for _, tt := range tests {
+		if got := normalizeGameName(tt.name); got != tt.want {
+			t.Errorf("normalizeGameName(%q) = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestGameNameInitials(t *testing.T) {
+	tests := []struct {
+		name string
+		want string
+	}{
+		{name: "Grand Theft Auto V", want: "gtav"},
+		{name: "Counter-Strike 2", want: "cs2"},
+		{name: "Minecraft", want: "m"},
+		{name: "Élden  Ring", want: "ér"},
+		{name: "", want: ""},
+	}
+
+	for _, tt := range tests {
+		if got := gameNameInitials(tt.name); got != tt.want {
+			t.Errorf("gameNameInitials(%q) = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
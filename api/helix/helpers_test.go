@@ -0,0 +1,151 @@
+package helix
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/kvizyx/twitchkit/api"
+	"github.com/kvizyx/twitchkit/api/oauth"
+)
+
+// fakeAuthProvider hands out never expiring tokens and counts refreshes. If scopes are
+// not nil, user access token has only them.
+type fakeAuthProvider struct {
+	refreshes int
+	scopes    []string
+}
+
+func (p *fakeAuthProvider) ClientID() string {
+	return "client-id"
+}
+
+func (p *fakeAuthProvider) AuthorizationType() api.AuthorizationType {
+	return api.AuthTypeBearer
+}
+
+func (p *fakeAuthProvider) AnyAccessToken(ctx context.Context, userID string) (oauth.AccessToken, error) {
+	token, err := p.UserAccessToken(ctx, userID, nil)
+	return &token, err
+}
+
+func (p *fakeAuthProvider) UserAccessToken(_ context.Context, _ string, scopes []string) (oauth.UserAccessToken, error) {
+	if absentScope, ok := oauth.IsScopesEqual(p.scopes, scopes); p.scopes != nil && !ok {
+		return oauth.UserAccessToken{}, oauth.MissingScopeError(absentScope)
+	}
+
+	return freshUserToken("user-token"), nil
+}
+
+func (p *fakeAuthProvider) AppAccessToken(context.Context, bool) (oauth.AppAccessToken, error) {
+	obtainedAt := oauth.ObtainTime(time.Now().Unix())
+
+	return oauth.AppAccessToken{
+		AccessTokenValue: "app-token",
+		TokenLifetime:    oauth.TokenLifetime{ExpiresInValue: 3600, ObtainedAtValue: &obtainedAt},
+	}, nil
+}
+
+func (p *fakeAuthProvider) RefreshUserAccessToken(context.Context, string) (oauth.UserAccessToken, error) {
+	p.refreshes++
+	return freshUserToken("refreshed-token"), nil
+}
+
+func freshUserToken(value string) oauth.UserAccessToken {
+	obtainedAt := oauth.ObtainTime(time.Now().Unix())
+
+	return oauth.UserAccessToken{
+		AccessTokenValue:  value,
+		RefreshTokenValue: "refresh-token",
+		TokenLifetime:     oauth.TokenLifetime{ExpiresInValue: 3600, ObtainedAtValue: &obtainedAt},
+	}
+}
+
+// fakeResponse is a response returned by fakeHTTPClient.
+type fakeResponse struct {
+	status int
+	body   string
+	header http.Header
+}
+
+// sentRequest is a request received by fakeHTTPClient.
+type sentRequest struct {
+	method        string
+	url           string
+	authorization string
+	body          string
+}
+
+// fakeHTTPClient returns scripted responses in order and records received requests.
+// The last response is repeated once the script is over.
+type fakeHTTPClient struct {
+	responses []fakeResponse
+
+	sent   []sentRequest
+	locker sync.Mutex
+}
+
+func (c *fakeHTTPClient) Do(req *http.Request) (*http.Response, error) {
+	c.locker.Lock()
+	defer c.locker.Unlock()
+
+	var body []byte
+	if req.Body != nil {
+		body, _ = io.ReadAll(req.Body)
+	}
+
+	c.sent = append(c.sent, sentRequest{
+		method:        req.Method,
+		url:           req.URL.String(),
+		authorization: req.Header.Get("Authorization"),
+		body:          string(body),
+	})
+
+	response := c.responses[min(len(c.sent), len(c.responses))-1]
+
+	header := response.header
+	if header == nil {
+		header = http.Header{}
+	}
+
+	return &http.Response{
+		StatusCode: response.status,
+		Status:     http.StatusText(response.status),
+		Header:     header,
+		Body:       io.NopCloser(strings.NewReader(response.body)),
+		Request:    req,
+	}, nil
+}
+
+func (c *fakeHTTPClient) requests() []sentRequest {
+	c.locker.Lock()
+	defer c.locker.Unlock()
+
+	return append([]sentRequest(nil), c.sent...)
+}
+
+// newTestClient returns Client that sends requests to httpClient and retries without
+// intervals.
+func newTestClient(t *testing.T, httpClient *fakeHTTPClient, provider *fakeAuthProvider) *Client {
+	t.Helper()
+
+	client, err := NewClient(ClientConfig{
+		AuthProvider: provider,
+		HTTPClient:   httpClient,
+		RetryConfig: RetryConfig{
+			RetryRateLimit:           true,
+			RetryUnavailable:         true,
+			RetryUnavailableTimes:    2,
+			RetryUnavailableInterval: NoRetryInterval,
+		},
+	})
+	if err != nil {
+		t.Fatalf("new client: %v", err)
+	}
+
+	return client
+}
@@ -0,0 +1,488 @@
+package helix
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	minScheduleSegmentDuration = 30 * time.Minute
+	maxScheduleSegmentDuration = 1380 * time.Minute
+	maxScheduleSegmentTitle    = 140
+
+	icalDateTimeLayout = "20060102T150405"
+	icalDateLayout     = "20060102"
+)
+
+// icalWeekdays are the BYDAY values of the weekdays.
+var icalWeekdays = [...]string{
+	time.Sunday:    "SU",
+	time.Monday:    "MO",
+	time.Tuesday:   "TU",
+	time.Wednesday: "WE",
+	time.Thursday:  "TH",
+	time.Friday:    "FR",
+	time.Saturday:  "SA",
+}
+
+// CalendarEvent is a VEVENT component of the iCalendar (RFC 5545).
+type CalendarEvent struct {
+	UID         string
+	Summary     string
+	Description string
+	Categories  []string
+	Start       time.Time
+	End         time.Time
+
+	// Timezone is an IANA time zone name from TZID parameter of DTSTART. It's empty if
+	// the start time is in UTC or floating.
+	Timezone string
+
+	// AllDay is true if the event start is a date without time.
+	AllDay bool
+
+	// RecurrenceRule is a raw value of RRULE property (e.g. "FREQ=WEEKLY;BYDAY=MO").
+	RecurrenceRule string
+	IsCanceled     bool
+}
+
+// IsRecurring reports whether the event repeats every week with no end. It's the only
+// recurrence that schedule segments support, so rules with INTERVAL other than 1, UNTIL,
+// COUNT, several BYDAY days or BYDAY other than the start day are not recurring in this
+// sense.
+func (e CalendarEvent) IsRecurring() bool {
+	return len(e.RecurrenceRule) != 0 && len(e.unsupportedRecurrence()) == 0
+}
+
+// unsupportedRecurrence returns why recurrence rule of the event can't be converted to
+// the recurring segment, it's empty if rule is supported or there is no rule.
+func (e CalendarEvent) unsupportedRecurrence() string {
+	if len(e.RecurrenceRule) == 0 {
+		return ""
+	}
+
+	weekly := false
+
+	for _, part := range strings.Split(e.RecurrenceRule, ";") {
+		key, value, _ := strings.Cut(part, "=")
+
+		switch strings.ToUpper(key) {
+		case "FREQ":
+			weekly = strings.EqualFold(value, "WEEKLY")
+		case "INTERVAL":
+			if interval, err := strconv.Atoi(value); err != nil || interval != 1 {
+				return "only weekly recurrence is supported, got INTERVAL=" + value
+			}
+		case "UNTIL", "COUNT":
+			return "recurrence must not end, got " + part
+		case "BYDAY":
+			if strings.Contains(value, ",") {
+				return "recurrence must be on a single day, got BYDAY=" + value
+			}
+
+			// segment repeats on the weekday of its start, so other day can't be kept.
+			if !strings.EqualFold(value, icalWeekdays[e.Start.Weekday()]) {
+				return "recurrence must be on the start day, got BYDAY=" + value
+			}
+		}
+	}
+
+	if !weekly {
+		return "only weekly recurrence is supported"
+	}
+
+	return ""
+}
+
+// SegmentInput converts the event to the input for ScheduleResource.CreateScheduleSegment.
+// Category of the segment is not set, since calendar categories are names, not IDs.
+func (e CalendarEvent) SegmentInput(broadcasterID string) (CreateScheduleSegmentInput, error) {
+	if reason := e.unsupportedRecurrence(); len(reason) != 0 {
+		return CreateScheduleSegmentInput{}, UnsupportedCalendarEventError(e.UID, reason)
+	}
+
+	duration := e.End.Sub(e.Start)
+	if duration < minScheduleSegmentDuration || duration > maxScheduleSegmentDuration {
+		return CreateScheduleSegmentInput{}, UnsupportedCalendarEventError(
+			e.UID, "duration must be from 30 minutes to 23 hours",
+		)
+	}
+
+	timezone := e.Timezone
+	if len(timezone) == 0 {
+		timezone = "UTC"
+	}
+
+	title := e.Summary
+	if utf8.RuneCountInString(title) > maxScheduleSegmentTitle {
+		title = string([]rune(title)[:maxScheduleSegmentTitle])
+	}
+
+	return CreateScheduleSegmentInput{
+		BroadcasterID: broadcasterID,
+		StartTime:     e.Start,
+		Timezone:      timezone,
+		Duration:      int(duration / time.Minute),
+		IsRecurring:   e.IsRecurring(),
+		Title:         title,
+	}, nil
+}
+
+// SegmentsFromICalendar parses the iCalendar and converts its events to the inputs for
+// ScheduleResource.CreateScheduleSegment. Canceled events are skipped.
+//
+// Events that can't be converted are skipped too, and their errors (wrapping
+// ErrUnsupportedCalendarEvent) are returned joined along with the segments of the other
+// events. Segments are nil only if the iCalendar itself can't be parsed.
+func SegmentsFromICalendar(r io.Reader, broadcasterID string) ([]CreateScheduleSegmentInput, error) {
+	events, err := ParseICalendar(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		segments = make([]CreateScheduleSegmentInput, 0, len(events))
+		skipped  []error
+	)
+
+	for _, event := range events {
+		if event.IsCanceled {
+			continue
+		}
+
+		segment, err := event.SegmentInput(broadcasterID)
+		if err != nil {
+			skipped = append(skipped, err)
+			continue
+		}
+
+		segments = append(segments, segment)
+	}
+
+	return segments, errors.Join(skipped...)
+}
+
+// icalProperty is a single content line of the iCalendar.
+type icalProperty struct {
+	line   int
+	name   string
+	params map[string]string
+	value  string
+}
+
+// ParseICalendar parses VEVENT components of the iCalendar (RFC 5545). Other components,
+// as well as components nested into events (e.g. VALARM), are ignored.
+func ParseICalendar(r io.Reader) ([]CalendarEvent, error) {
+	properties, err := readICalProperties(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		events []CalendarEvent
+		event  *CalendarEvent
+
+		// duration is a value of DURATION property used if event has no DTEND.
+		duration time.Duration
+
+		// nested is a depth of components nested into the current event.
+		nested int
+	)
+
+	for _, property := range properties {
+		switch {
+		case property.name == "BEGIN" && event == nil:
+			if strings.EqualFold(property.value, "VEVENT") {
+				event = &CalendarEvent{}
+				duration = 0
+			}
+
+			continue
+		case property.name == "BEGIN":
+			nested++
+			continue
+		case property.name == "END" && nested > 0:
+			nested--
+			continue
+		case property.name == "END" && event != nil:
+			if !strings.EqualFold(property.value, "VEVENT") {
+				return nil, InvalidICalendarError(property.line, "unexpected END:"+property.value)
+			}
+
+			if event.End.IsZero() {
+				event.End = event.Start.Add(duration)
+				if event.AllDay && duration == 0 {
+					event.End = event.Start.AddDate(0, 0, 1)
+				}
+			}
+
+			events = append(events, *event)
+			event = nil
+
+			continue
+		case event == nil || nested > 0:
+			continue
+		}
+
+		switch property.name {
+		case "UID":
+			event.UID = property.value
+		case "SUMMARY":
+			event.Summary = unescapeICalText(property.value)
+		case "DESCRIPTION":
+			event.Description = unescapeICalText(property.value)
+		case "CATEGORIES":
+			event.Categories = append(event.Categories, splitICalText(property.value)...)
+		case "RRULE":
+			event.RecurrenceRule = property.value
+		case "STATUS":
+			event.IsCanceled = strings.EqualFold(property.value, "CANCELLED")
+		case "DTSTART":
+			start, timezone, allDay, err := parseICalTime(property)
+			if err != nil {
+				return nil, err
+			}
+
+			event.Start, event.Timezone, event.AllDay = start, timezone, allDay
+		case "DTEND":
+			end, _, _, err := parseICalTime(property)
+			if err != nil {
+				return nil, err
+			}
+
+			event.End = end
+		case "DURATION":
+			duration, err = parseICalDuration(property.value)
+			if err != nil {
+				return nil, InvalidICalendarError(property.line, err.Error())
+			}
+		}
+	}
+
+	if event != nil {
+		return nil, InvalidICalendarError(len(properties), "VEVENT is not closed")
+	}
+
+	return events, nil
+}
+
+// readICalProperties reads unfolded content lines of the iCalendar.
+func readICalProperties(r io.Reader) ([]icalProperty, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+
+	var (
+		lines      []string
+		lineNumber []int
+		number     int
+	)
+
+	for scanner.Scan() {
+		number++
+
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if len(line) == 0 {
+			continue
+		}
+
+		// folded line continues the previous one after a single whitespace.
+		if (line[0] == ' ' || line[0] == '\t') && len(lines) != 0 {
+			lines[len(lines)-1] += line[1:]
+			continue
+		}
+
+		lines = append(lines, line)
+		lineNumber = append(lineNumber, number)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	properties := make([]icalProperty, 0, len(lines))
+
+	for i, line := range lines {
+		property, ok := parseICalProperty(line)
+		if !ok {
+			return nil, InvalidICalendarError(lineNumber[i], "malformed content line")
+		}
+
+		property.line = lineNumber[i]
+		properties = append(properties, property)
+	}
+
+	return properties, nil
+}
+
+// parseICalProperty parses content line in the form of "NAME;PARAM=VALUE:VALUE".
+func parseICalProperty(line string) (icalProperty, bool) {
+	var (
+		property = icalProperty{params: make(map[string]string)}
+		quoted   bool
+		start    int
+		name     = true
+		param    string
+	)
+
+	for i := 0; i < len(line); i++ {
+		switch c := line[i]; {
+		case c == '"':
+			quoted = !quoted
+		case quoted:
+		case c == ';' || c == ':':
+			part := line[start:i]
+
+			if name {
+				property.name = strings.ToUpper(part)
+				name = false
+			} else if len(param) != 0 {
+				property.params[param] = strings.Trim(part, `"`)
+				param = ""
+			}
+
+			start = i + 1
+
+			if c == ':' {
+				property.value = line[i+1:]
+				return property, len(property.name) != 0
+			}
+		case c == '=' && !name && len(param) == 0:
+			param = strings.ToUpper(line[start:i])
+			start = i + 1
+		}
+	}
+
+	return icalProperty{}, false
+}
+
+// parseICalTime parses DATE-TIME or DATE value of the property, taking its TZID
+// parameter into account. Floating time is treated as UTC.
+func parseICalTime(property icalProperty) (time.Time, string, bool, error) {
+	if property.params["VALUE"] == "DATE" || len(property.value) == len(icalDateLayout) {
+		t, err := time.Parse(icalDateLayout, property.value)
+		if err != nil {
+			return time.Time{}, "", false, InvalidICalendarError(property.line, err.Error())
+		}
+
+		return t, "", true, nil
+	}
+
+	if strings.HasSuffix(property.value, "Z") {
+		t, err := time.Parse(icalDateTimeLayout, strings.TrimSuffix(property.value, "Z"))
+		if err != nil {
+			return time.Time{}, "", false, InvalidICalendarError(property.line, err.Error())
+		}
+
+		return t, "", false, nil
+	}
+
+	location := time.UTC
+
+	// some producers prefix TZID with a slash to mark it as globally unique.
+	timezone := strings.TrimPrefix(property.params["TZID"], "/")
+	if len(timezone) != 0 {
+		var err error
+
+		location, err = time.LoadLocation(timezone)
+		if err != nil {
+			return time.Time{}, "", false, InvalidICalendarError(property.line, "unknown TZID "+timezone)
+		}
+	}
+
+	t, err := time.ParseInLocation(icalDateTimeLayout, property.value, location)
+	if err != nil {
+		return time.Time{}, "", false, InvalidICalendarError(property.line, err.Error())
+	}
+
+	return t, timezone, false, nil
+}
+
+// parseICalDuration parses duration value (e.g. "PT1H30M" or "P1D").
+func parseICalDuration(value string) (time.Duration, error) {
+	rest, ok := strings.CutPrefix(strings.TrimPrefix(value, "+"), "P")
+	if !ok {
+		return 0, strconv.ErrSyntax
+	}
+
+	var (
+		duration time.Duration
+		inTime   bool
+		number   int
+	)
+
+	units := map[bool]map[byte]time.Duration{
+		false: {'W': 7 * 24 * time.Hour, 'D': 24 * time.Hour},
+		true:  {'H': time.Hour, 'M': time.Minute, 'S': time.Second},
+	}
+
+	for i := 0; i < len(rest); i++ {
+		c := rest[i]
+
+		switch {
+		case c >= '0' && c <= '9':
+			number = number*10 + int(c-'0')
+		case c == 'T' && !inTime:
+			inTime = true
+		default:
+			unit, ok := units[inTime][c]
+			if !ok {
+				return 0, strconv.ErrSyntax
+			}
+
+			duration += time.Duration(number) * unit
+			number = 0
+		}
+	}
+
+	return duration, nil
+}
+
+// unescapeICalText unescapes TEXT value.
+func unescapeICalText(value string) string {
+	parts := splitICalTextSep(value, false)
+	if len(parts) == 0 {
+		return ""
+	}
+
+	return parts[0]
+}
+
+// splitICalText unescapes multi-value TEXT separated by commas.
+func splitICalText(value string) []string {
+	return splitICalTextSep(value, true)
+}
+
+func splitICalTextSep(value string, split bool) []string {
+	var (
+		parts   []string
+		current strings.Builder
+	)
+
+	for i := 0; i < len(value); i++ {
+		c := value[i]
+
+		switch {
+		case c == '\\' && i+1 < len(value):
+			i++
+
+			switch value[i] {
+			case 'n', 'N':
+				current.WriteByte('\n')
+			default:
+				current.WriteByte(value[i])
+			}
+		case c == ',' && split:
+			parts = append(parts, current.String())
+			current.Reset()
+		default:
+			current.WriteByte(c)
+		}
+	}
+
+	return append(parts, current.String())
+}
@@ -0,0 +1,127 @@
+package helix
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestCalendarEventRecurrence(t *testing.T) {
+	tests := []struct {
+		rule          string
+		wantRecurring bool
+		wantErr       bool
+	}{
+		{rule: ""},
+		{rule: "FREQ=WEEKLY", wantRecurring: true},
+		{rule: "FREQ=WEEKLY;BYDAY=MO", wantRecurring: true},
+		{rule: "freq=weekly;interval=1;byday=mo", wantRecurring: true},
+		{rule: "FREQ=WEEKLY;INTERVAL=2", wantErr: true},
+		{rule: "FREQ=WEEKLY;UNTIL=20261231T000000Z", wantErr: true},
+		{rule: "FREQ=WEEKLY;COUNT=4", wantErr: true},
+		{rule: "FREQ=WEEKLY;BYDAY=MO,WE", wantErr: true},
+		{rule: "FREQ=WEEKLY;BYDAY=TU", wantErr: true},
+		{rule: "FREQ=DAILY", wantErr: true},
+	}
+
+	start := time.Date(2026, time.October, 19, 18, 0, 0, 0, time.UTC)
+
+	for _, tt := range tests {
+		t.Run(tt.rule, func(t *testing.T) {
+			event := CalendarEvent{
+				UID:            "event",
+				Start:          start,
+				End:            start.Add(2 * time.Hour),
+				RecurrenceRule: tt.rule,
+			}
+
+			if got := event.IsRecurring(); got != tt.wantRecurring {
+				t.Errorf("IsRecurring() = %t, want %t", got, tt.wantRecurring)
+			}
+
+			segment, err := event.SegmentInput("1")
+			if tt.wantErr {
+				if !errors.Is(err, ErrUnsupportedCalendarEvent) {
+					t.Errorf("err = %v, want %v", err, ErrUnsupportedCalendarEvent)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("segment input: %v", err)
+			}
+
+			if segment.IsRecurring != tt.wantRecurring {
+				t.Errorf("segment is recurring = %t, want %t", segment.IsRecurring, tt.wantRecurring)
+			}
+		})
+	}
+}
+
+func TestSegmentsFromICalendarSkipsUnsupportedEvents(t *testing.T) {
+	const calendar = `BEGIN:VCALENDAR
+VERSION:2.0
+BEGIN:VEVENT
+UID:weekly
+SUMMARY:Weekly stream
+DTSTART;TZID=Europe/Berlin:20261019T200000
+DTEND;TZID=Europe/Berlin:20261019T220000
+RRULE:FREQ=WEEKLY;BYDAY=MO
+END:VEVENT
+BEGIN:VEVENT
+UID:other-day
+SUMMARY:Stream on the wrong day
+DTSTART;TZID=Europe/Berlin:20261019T200000
+DTEND;TZID=Europe/Berlin:20261019T220000
+RRULE:FREQ=WEEKLY;BYDAY=FR
+END:VEVENT
+BEGIN:VEVENT
+UID:too-short
+SUMMARY:Short stream
+DTSTART:20261020T180000Z
+DTEND:20261020T181000Z
+END:VEVENT
+BEGIN:VEVENT
+UID:canceled
+SUMMARY:Canceled stream
+DTSTART:20261021T180000Z
+DURATION:PT2H
+STATUS:CANCELLED
+END:VEVENT
+BEGIN:VEVENT
+UID:single
+SUMMARY:Special stream
+DTSTART:20261022T180000Z
+DURATION:PT3H
+END:VEVENT
+END:VCALENDAR
+`
+
+	segments, err := SegmentsFromICalendar(strings.NewReader(calendar), "1")
+
+	if !errors.Is(err, ErrUnsupportedCalendarEvent) {
+		t.Fatalf("err = %v, want %v", err, ErrUnsupportedCalendarEvent)
+	}
+
+	for _, uid := range []string{"other-day", "too-short"} {
+		if !strings.Contains(err.Error(), uid) {
+			t.Errorf("err = %v, want it to mention event %q", err, uid)
+		}
+	}
+
+	if len(segments) != 2 {
+		t.Fatalf("got %d segments, want 2", len(segments))
+	}
+
+	if segment := segments[0]; segment.Title != "Weekly stream" || !segment.IsRecurring ||
+		segment.Timezone != "Europe/Berlin" || segment.Duration != 120 {
+		t.Errorf("weekly segment = %+v", segment)
+	}
+
+	if segment := segments[1]; segment.Title != "Special stream" || segment.IsRecurring ||
+		segment.Timezone != "UTC" || segment.Duration != 180 {
+		t.Errorf("single segment = %+v", segment)
+	}
+}
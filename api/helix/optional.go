@@ -0,0 +1,8 @@
+package helix
+
+// Ptr returns pointer to the given value. It's useful for optional input fields,
+// where nil means that the field is not set at all and pointer to zero value means
+// that the field should be set to zero value.
+func Ptr[T any](value T) *T {
+	return &value
+}
@@ -0,0 +1,50 @@
+package helix
+
+import (
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// setQuery sets value to the query if it's not empty.
+func setQuery(values url.Values, key, value string) {
+	if len(value) == 0 {
+		return
+	}
+
+	values.Set(key, value)
+}
+
+// setQueryInt sets value to the query if it's not zero.
+func setQueryInt(values url.Values, key string, value int) {
+	if value == 0 {
+		return
+	}
+
+	values.Set(key, strconv.Itoa(value))
+}
+
+// setQueryBool sets value to the query if it's not nil.
+func setQueryBool(values url.Values, key string, value *bool) {
+	if value == nil {
+		return
+	}
+
+	values.Set(key, strconv.FormatBool(*value))
+}
+
+// setQueryTime sets value in RFC3339 format to the query if it's not zero.
+func setQueryTime(values url.Values, key string, value time.Time) {
+	if value.IsZero() {
+		return
+	}
+
+	values.Set(key, value.UTC().Format(time.RFC3339))
+}
+
+// addQuery adds every value to the query under the same key.
+func addQuery(values url.Values, key string, items []string) {
+	for _, item := range items {
+		values.Add(key, item)
+	}
+}
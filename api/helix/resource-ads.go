@@ -2,7 +2,11 @@ package helix
 
 import (
 	"context"
+	"encoding/json"
 	"net/http"
+	"net/url"
+	"strconv"
+	"time"
 
 	"github.com/kvizyx/twitchkit/api"
 	"github.com/kvizyx/twitchkit/http-core"
@@ -69,6 +73,177 @@ func (r AdsResource) StartCommercial(ctx context.Context, input StartCommercialI
 
 	if len(wrapper.Data) != 0 {
 		output = wrapper.Data[0]
+		output.ResponseMetadata = metadata
+	}
+
+	return output, nil
+}
+
+// AdTime is a time in the ad schedule. Twitch sends it either as a unix timestamp or as
+// a RFC3339 string, and it's zero if there is no such time (e.g. no ad is scheduled).
+type AdTime struct {
+	time.Time
+}
+
+func (t *AdTime) UnmarshalJSON(data []byte) error {
+	var value any
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+
+	switch value := value.(type) {
+	case float64:
+		if value != 0 {
+			t.Time = time.Unix(int64(value), 0)
+		}
+	case string:
+		if len(value) == 0 {
+			return nil
+		}
+
+		if seconds, err := strconv.ParseInt(value, 10, 64); err == nil {
+			t.Time = time.Unix(seconds, 0)
+			return nil
+		}
+
+		parsed, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			return err
+		}
+
+		t.Time = parsed
+	}
+
+	return nil
+}
+
+type (
+	AdSchedule struct {
+		// NextAdAt is zero if the channel has no ad scheduled or is not live.
+		NextAdAt AdTime `json:"next_ad_at"`
+		LastAdAt AdTime `json:"last_ad_at"`
+
+		// Duration is a length of the next ad break in seconds.
+		Duration int `json:"duration"`
+
+		// PrerollFreeTime is an amount of pre-roll free time remaining for the channel
+		// in seconds.
+		PrerollFreeTime int    `json:"preroll_free_time"`
+		SnoozeCount     int    `json:"snooze_count"`
+		SnoozeRefreshAt AdTime `json:"snooze_refresh_at"`
+	}
+
+	AdScheduleWrapper struct {
+		Data []AdSchedule `json:"data"`
+	}
+
+	GetAdScheduleOutput struct {
+		AdSchedule       AdSchedule
+		ResponseMetadata api.ResponseMetadata
+	}
+)
+
+// GetAdSchedule gets ad schedule related information, including snooze, when the last
+// ad was run, when the next ad is scheduled, and if the channel is currently in pre-roll
+// free time.
+//
+// Reference: https://dev.twitch.tv/docs/api/reference/#get-ad-schedule
+//
+// Requires a user access token that includes the channel:read:ads scope.
+func (r AdsResource) GetAdSchedule(ctx context.Context, broadcasterID string) (GetAdScheduleOutput, error) {
+	const resource = "channels/ads"
+
+	values := url.Values{}
+	values.Set("broadcaster_id", broadcasterID)
+
+	req, err := httpcore.NewAPIRequest(ctx, httpcore.RequestOptions{
+		APIType:   api.TypeHelix,
+		Resource:  resource,
+		Method:    http.MethodGet,
+		URLValues: values,
+	}, false)
+	if err != nil {
+		return GetAdScheduleOutput{}, err
+	}
+
+	var (
+		wrapper AdScheduleWrapper
+		output  GetAdScheduleOutput
+	)
+
+	metadata, err := r.client.doRequest(req, &wrapper, RequestAuthParams{
+		UserID: broadcasterID,
+		Scopes: []string{"channel:read:ads"},
+	})
+	output.ResponseMetadata = metadata
+
+	if err != nil {
+		return output, err
+	}
+
+	if len(wrapper.Data) != 0 {
+		output.AdSchedule = wrapper.Data[0]
+	}
+
+	return output, nil
+}
+
+type (
+	SnoozedAd struct {
+		SnoozeCount     int    `json:"snooze_count"`
+		SnoozeRefreshAt AdTime `json:"snooze_refresh_at"`
+		NextAdAt        AdTime `json:"next_ad_at"`
+	}
+
+	SnoozeNextAdWrapper struct {
+		Data []SnoozedAd `json:"data"`
+	}
+
+	SnoozeNextAdOutput struct {
+		SnoozedAd        SnoozedAd
+		ResponseMetadata api.ResponseMetadata
+	}
+)
+
+// SnoozeNextAd pushes back the timestamp of the upcoming automatic mid-roll ad by 5
+// minutes. It's possible only if the broadcaster has snoozes left.
+//
+// Reference: https://dev.twitch.tv/docs/api/reference/#snooze-next-ad
+//
+// Requires a user access token that includes the channel:manage:ads scope.
+func (r AdsResource) SnoozeNextAd(ctx context.Context, broadcasterID string) (SnoozeNextAdOutput, error) {
+	const resource = "channels/ads/schedule/snooze"
+
+	values := url.Values{}
+	values.Set("broadcaster_id", broadcasterID)
+
+	req, err := httpcore.NewAPIRequest(ctx, httpcore.RequestOptions{
+		APIType:   api.TypeHelix,
+		Resource:  resource,
+		Method:    http.MethodPost,
+		URLValues: values,
+	}, false)
+	if err != nil {
+		return SnoozeNextAdOutput{}, err
+	}
+
+	var (
+		wrapper SnoozeNextAdWrapper
+		output  SnoozeNextAdOutput
+	)
+
+	metadata, err := r.client.doRequest(req, &wrapper, RequestAuthParams{
+		UserID: broadcasterID,
+		Scopes: []string{"channel:manage:ads"},
+	})
+	output.ResponseMetadata = metadata
+
+	if err != nil {
+		return output, err
+	}
+
+	if len(wrapper.Data) != 0 {
+		output.SnoozedAd = wrapper.Data[0]
 	}
 
 	return output, nil
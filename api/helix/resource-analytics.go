@@ -0,0 +1,184 @@
+package helix
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/kvizyx/twitchkit/api"
+	"github.com/kvizyx/twitchkit/http-core"
+)
+
+type AnalyticsResource struct {
+	client Client
+}
+
+func (c Client) Analytics() AnalyticsResource {
+	return AnalyticsResource{client: c}
+}
+
+// AnalyticsReportType is a type of the analytics report.
+type AnalyticsReportType string
+
+const AnalyticsReportOverviewV2 AnalyticsReportType = "overview_v2"
+
+type (
+	ExtensionAnalyticsReport struct {
+		ExtensionID string `json:"extension_id"`
+
+		// URL is a URL of the CSV report, which expires after 5 minutes. Use
+		// AnalyticsResource.DownloadReport to get its rows.
+		URL       string              `json:"URL"`
+		Type      AnalyticsReportType `json:"type"`
+		DateRange DateRange           `json:"date_range"`
+	}
+
+	GetExtensionAnalyticsInput struct {
+		// UserID is an ID of the user who owns the extensions. User access token of this
+		// user is used for the request.
+		UserID string
+
+		// ExtensionID returns report of the single extension instead of all of them.
+		ExtensionID string
+		Type        AnalyticsReportType
+
+		// StartedAt and EndedAt must be both set or both zero. By default, the report
+		// covers all available data.
+		StartedAt time.Time
+		EndedAt   time.Time
+		First     int
+		After     string
+	}
+
+	GetExtensionAnalyticsOutput struct {
+		Reports          []ExtensionAnalyticsReport `json:"data"`
+		Pagination       Pagination                 `json:"pagination"`
+		ResponseMetadata api.ResponseMetadata
+	}
+)
+
+// GetExtensionAnalytics gets URLs of analytics reports for one or more extensions that
+// the user owns.
+//
+// Reference: https://dev.twitch.tv/docs/api/reference/#get-extension-analytics
+//
+// Requires a user access token that includes the analytics:read:extensions scope.
+func (r AnalyticsResource) GetExtensionAnalytics(
+	ctx context.Context,
+	input GetExtensionAnalyticsInput,
+) (GetExtensionAnalyticsOutput, error) {
+	const resource = "analytics/extensions"
+
+	values := url.Values{}
+	setQuery(values, "extension_id", input.ExtensionID)
+	setQuery(values, "type", string(input.Type))
+	setQueryTime(values, "started_at", input.StartedAt)
+	setQueryTime(values, "ended_at", input.EndedAt)
+	setQueryInt(values, "first", input.First)
+	setQuery(values, "after", input.After)
+
+	req, err := httpcore.NewAPIRequest(ctx, httpcore.RequestOptions{
+		APIType:   api.TypeHelix,
+		Resource:  resource,
+		Method:    http.MethodGet,
+		URLValues: values,
+	}, false)
+	if err != nil {
+		return GetExtensionAnalyticsOutput{}, err
+	}
+
+	var output GetExtensionAnalyticsOutput
+
+	metadata, err := r.client.doRequest(req, &output, RequestAuthParams{
+		UserID: input.UserID,
+		Scopes: []string{"analytics:read:extensions"},
+	})
+	output.ResponseMetadata = metadata
+
+	if err != nil {
+		return output, err
+	}
+
+	return output, nil
+}
+
+type (
+	GameAnalyticsReport struct {
+		GameID string `json:"game_id"`
+
+		// URL is a URL of the CSV report, which expires after 5 minutes. Use
+		// AnalyticsResource.DownloadReport to get its rows.
+		URL       string              `json:"URL"`
+		Type      AnalyticsReportType `json:"type"`
+		DateRange DateRange           `json:"date_range"`
+	}
+
+	GetGameAnalyticsInput struct {
+		// UserID is an ID of the user who owns the games. User access token of this user
+		// is used for the request.
+		UserID string
+
+		// GameID returns report of the single game instead of all of them.
+		GameID string
+		Type   AnalyticsReportType
+
+		// StartedAt and EndedAt must be both set or both zero. By default, the report
+		// covers the last 365 days.
+		StartedAt time.Time
+		EndedAt   time.Time
+		First     int
+		After     string
+	}
+
+	GetGameAnalyticsOutput struct {
+		Reports          []GameAnalyticsReport `json:"data"`
+		Pagination       Pagination            `json:"pagination"`
+		ResponseMetadata api.ResponseMetadata
+	}
+)
+
+// GetGameAnalytics gets URLs of analytics reports for one or more games that the user
+// owns.
+//
+// Reference: https://dev.twitch.tv/docs/api/reference/#get-game-analytics
+//
+// Requires a user access token that includes the analytics:read:games scope.
+func (r AnalyticsResource) GetGameAnalytics(
+	ctx context.Context,
+	input GetGameAnalyticsInput,
+) (GetGameAnalyticsOutput, error) {
+	const resource = "analytics/games"
+
+	values := url.Values{}
+	setQuery(values, "game_id", input.GameID)
+	setQuery(values, "type", string(input.Type))
+	setQueryTime(values, "started_at", input.StartedAt)
+	setQueryTime(values, "ended_at", input.EndedAt)
+	setQueryInt(values, "first", input.First)
+	setQuery(values, "after", input.After)
+
+	req, err := httpcore.NewAPIRequest(ctx, httpcore.RequestOptions{
+		APIType:   api.TypeHelix,
+		Resource:  resource,
+		Method:    http.MethodGet,
+		URLValues: values,
+	}, false)
+	if err != nil {
+		return GetGameAnalyticsOutput{}, err
+	}
+
+	var output GetGameAnalyticsOutput
+
+	metadata, err := r.client.doRequest(req, &output, RequestAuthParams{
+		UserID: input.UserID,
+		Scopes: []string{"analytics:read:games"},
+	})
+	output.ResponseMetadata = metadata
+
+	if err != nil {
+		return output, err
+	}
+
+	return output, nil
+}
@@ -0,0 +1,264 @@
+package helix
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/kvizyx/twitchkit/api"
+	"github.com/kvizyx/twitchkit/http-core"
+)
+
+const maxExtensionTransactionsPerRequest = 100
+
+type BitsResource struct {
+	client Client
+}
+
+func (c Client) Bits() BitsResource {
+	return BitsResource{client: c}
+}
+
+// BitsLeaderboardPeriod is a time period over which data is aggregated.
+type BitsLeaderboardPeriod string
+
+const (
+	BitsLeaderboardDay   BitsLeaderboardPeriod = "day"
+	BitsLeaderboardWeek  BitsLeaderboardPeriod = "week"
+	BitsLeaderboardMonth BitsLeaderboardPeriod = "month"
+	BitsLeaderboardYear  BitsLeaderboardPeriod = "year"
+	BitsLeaderboardAll   BitsLeaderboardPeriod = "all"
+)
+
+type (
+	BitsLeader struct {
+		UserID    string `json:"user_id"`
+		UserLogin string `json:"user_login"`
+		UserName  string `json:"user_name"`
+		Rank      int    `json:"rank"`
+		Score     int    `json:"score"`
+	}
+
+	// DateRange is a RFC3339 time range of the reported data, both times are empty if
+	// there is no range (e.g. for BitsLeaderboardAll period).
+	DateRange struct {
+		StartedAt string `json:"started_at"`
+		EndedAt   string `json:"ended_at"`
+	}
+
+	GetBitsLeaderboardInput struct {
+		// BroadcasterID is an ID of the broadcaster whose leaderboard is returned. User
+		// access token of this user is used for the request.
+		BroadcasterID string
+
+		// Count is a number of results to return (from 1 to 100, default is 10).
+		Count  int
+		Period BitsLeaderboardPeriod
+
+		// StartedAt is a start date of the period, it's ignored if Period is
+		// BitsLeaderboardAll.
+		StartedAt time.Time
+		UserID    string
+	}
+
+	GetBitsLeaderboardOutput struct {
+		Leaders          []BitsLeader `json:"data"`
+		DateRange        DateRange    `json:"date_range"`
+		Total            int          `json:"total"`
+		ResponseMetadata api.ResponseMetadata
+	}
+)
+
+// GetBitsLeaderboard gets the Bits leaderboard for the authenticated broadcaster.
+//
+// Reference: https://dev.twitch.tv/docs/api/reference/#get-bits-leaderboard
+//
+// Requires a user access token that includes the bits:read scope.
+func (r BitsResource) GetBitsLeaderboard(
+	ctx context.Context,
+	input GetBitsLeaderboardInput,
+) (GetBitsLeaderboardOutput, error) {
+	const resource = "bits/leaderboard"
+
+	values := url.Values{}
+	setQueryInt(values, "count", input.Count)
+	setQuery(values, "period", string(input.Period))
+	setQueryTime(values, "started_at", input.StartedAt)
+	setQuery(values, "user_id", input.UserID)
+
+	req, err := httpcore.NewAPIRequest(ctx, httpcore.RequestOptions{
+		APIType:   api.TypeHelix,
+		Resource:  resource,
+		Method:    http.MethodGet,
+		URLValues: values,
+	}, false)
+	if err != nil {
+		return GetBitsLeaderboardOutput{}, err
+	}
+
+	var output GetBitsLeaderboardOutput
+
+	metadata, err := r.client.doRequest(req, &output, RequestAuthParams{
+		UserID: input.BroadcasterID,
+		Scopes: []string{"bits:read"},
+	})
+	output.ResponseMetadata = metadata
+
+	if err != nil {
+		return output, err
+	}
+
+	return output, nil
+}
+
+type (
+	Cheermote struct {
+		Prefix       string          `json:"prefix"`
+		Tiers        []CheermoteTier `json:"tiers"`
+		Type         string          `json:"type"`
+		Order        int             `json:"order"`
+		LastUpdated  time.Time       `json:"last_updated"`
+		IsCharitable bool            `json:"is_charitable"`
+	}
+
+	CheermoteTier struct {
+		MinBits        int             `json:"min_bits"`
+		ID             string          `json:"id"`
+		Color          string          `json:"color"`
+		Images         CheermoteImages `json:"images"`
+		CanCheer       bool            `json:"can_cheer"`
+		ShowInBitsCard bool            `json:"show_in_bits_card"`
+	}
+
+	// CheermoteImages are URLs of the cheermote images by theme ("dark", "light"),
+	// format ("animated", "static") and scale ("1", "1.5", "2", "3", "4").
+	CheermoteImages map[string]map[string]map[string]string
+
+	GetCheermotesOutput struct {
+		Cheermotes       []Cheermote `json:"data"`
+		ResponseMetadata api.ResponseMetadata
+	}
+)
+
+// GetCheermotes gets a list of Cheermotes that users can use to cheer Bits in any
+// Bits-enabled channel’s chat room. Broadcaster's custom Cheermotes are included if
+// broadcasterID is not empty.
+//
+// Reference: https://dev.twitch.tv/docs/api/reference/#get-cheermotes
+//
+// Requires an app access token or user access token.
+func (r BitsResource) GetCheermotes(ctx context.Context, broadcasterID string) (GetCheermotesOutput, error) {
+	const resource = "bits/cheermotes"
+
+	values := url.Values{}
+	setQuery(values, "broadcaster_id", broadcasterID)
+
+	req, err := httpcore.NewAPIRequest(ctx, httpcore.RequestOptions{
+		APIType:   api.TypeHelix,
+		Resource:  resource,
+		Method:    http.MethodGet,
+		URLValues: values,
+	}, false)
+	if err != nil {
+		return GetCheermotesOutput{}, err
+	}
+
+	var output GetCheermotesOutput
+
+	metadata, err := r.client.doRequest(req, &output, RequestAuthParams{AppToken: true})
+	output.ResponseMetadata = metadata
+
+	if err != nil {
+		return output, err
+	}
+
+	return output, nil
+}
+
+type (
+	ExtensionTransaction struct {
+		ID               string                   `json:"id"`
+		Timestamp        time.Time                `json:"timestamp"`
+		BroadcasterID    string                   `json:"broadcaster_id"`
+		BroadcasterLogin string                   `json:"broadcaster_login"`
+		BroadcasterName  string                   `json:"broadcaster_name"`
+		UserID           string                   `json:"user_id"`
+		UserLogin        string                   `json:"user_login"`
+		UserName         string                   `json:"user_name"`
+		ProductType      string                   `json:"product_type"`
+		ProductData      ExtensionTransactionData `json:"product_data"`
+	}
+
+	ExtensionTransactionData struct {
+		SKU           string      `json:"sku"`
+		Domain        string      `json:"domain"`
+		Cost          ProductCost `json:"cost"`
+		InDevelopment bool        `json:"inDevelopment"`
+		DisplayName   string      `json:"displayName"`
+		Expiration    string      `json:"expiration"`
+		Broadcast     bool        `json:"broadcast"`
+	}
+
+	ProductCost struct {
+		Amount int    `json:"amount"`
+		Type   string `json:"type"`
+	}
+
+	GetExtensionTransactionsInput struct {
+		ExtensionID string
+		IDs         []string
+		First       int
+		After       string
+	}
+
+	GetExtensionTransactionsOutput struct {
+		Transactions     []ExtensionTransaction `json:"data"`
+		Pagination       Pagination             `json:"pagination"`
+		ResponseMetadata api.ResponseMetadata
+	}
+)
+
+// GetExtensionTransactions gets an extension’s list of transactions. A transaction
+// records the exchange of a currency (for example, Bits) for a digital product.
+//
+// Reference: https://dev.twitch.tv/docs/api/reference/#get-extension-transactions
+//
+// Requires an app access token.
+func (r BitsResource) GetExtensionTransactions(
+	ctx context.Context,
+	input GetExtensionTransactionsInput,
+) (GetExtensionTransactionsOutput, error) {
+	const resource = "extensions/transactions"
+
+	if len(input.IDs) > maxExtensionTransactionsPerRequest {
+		return GetExtensionTransactionsOutput{}, TooManyItemsError("IDs", maxExtensionTransactionsPerRequest)
+	}
+
+	values := url.Values{}
+	values.Set("extension_id", input.ExtensionID)
+	addQuery(values, "id", input.IDs)
+	setQueryInt(values, "first", input.First)
+	setQuery(values, "after", input.After)
+
+	req, err := httpcore.NewAPIRequest(ctx, httpcore.RequestOptions{
+		APIType:   api.TypeHelix,
+		Resource:  resource,
+		Method:    http.MethodGet,
+		URLValues: values,
+	}, false)
+	if err != nil {
+		return GetExtensionTransactionsOutput{}, err
+	}
+
+	var output GetExtensionTransactionsOutput
+
+	metadata, err := r.client.doRequest(req, &output, RequestAuthParams{AppToken: true})
+	output.ResponseMetadata = metadata
+
+	if err != nil {
+		return output, err
+	}
+
+	return output, nil
+}
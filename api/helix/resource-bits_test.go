@@ -0,0 +1,52 @@
+package helix
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func TestGetBitsLeaderboardAllTime(t *testing.T) {
+	// for the all-time period, Twitch sends empty dates.
+	httpClient := &fakeHTTPClient{responses: []fakeResponse{{
+		status: http.StatusOK,
+		body: `{"data":[{"user_id":"2","user_login":"viewer","user_name":"Viewer","rank":1,"score":100}],` +
+			`"date_range":{"started_at":"","ended_at":""},"total":1}`,
+	}}}
+
+	client := newTestClient(t, httpClient, &fakeAuthProvider{})
+
+	output, err := client.Bits().GetBitsLeaderboard(context.Background(), GetBitsLeaderboardInput{
+		BroadcasterID: "1",
+	})
+	if err != nil {
+		t.Fatalf("get bits leaderboard: %v", err)
+	}
+
+	if len(output.Leaders) != 1 || output.Leaders[0].Score != 100 {
+		t.Errorf("leaders = %+v", output.Leaders)
+	}
+
+	if output.DateRange != (DateRange{}) {
+		t.Errorf("date range = %+v, want empty", output.DateRange)
+	}
+}
+
+func TestGetExtensionTransactionsUsesAppToken(t *testing.T) {
+	httpClient := &fakeHTTPClient{responses: []fakeResponse{{status: http.StatusOK, body: `{"data":[]}`}}}
+	client := newTestClient(t, httpClient, &fakeAuthProvider{})
+
+	client.AsUser("1", func(client Client) {
+		_, err := client.Bits().GetExtensionTransactions(context.Background(), GetExtensionTransactionsInput{
+			ExtensionID: "extension",
+		})
+		if err != nil {
+			t.Fatalf("get extension transactions: %v", err)
+		}
+	})
+
+	sent := httpClient.requests()
+	if len(sent) != 1 || sent[0].authorization != "Bearer app-token" {
+		t.Errorf("sent requests = %+v, want one with app access token", sent)
+	}
+}
@@ -0,0 +1,516 @@
+package helix
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/kvizyx/twitchkit/api"
+	"github.com/kvizyx/twitchkit/http-core"
+)
+
+const (
+	maxCustomRewardsPerRequest = 50
+	maxRedemptionsPerRequest   = 50
+)
+
+type ChannelPointsResource struct {
+	client Client
+}
+
+func (c Client) ChannelPoints() ChannelPointsResource {
+	return ChannelPointsResource{client: c}
+}
+
+type (
+	CustomReward struct {
+		BroadcasterID                     string                     `json:"broadcaster_id"`
+		BroadcasterLogin                  string                     `json:"broadcaster_login"`
+		BroadcasterName                   string                     `json:"broadcaster_name"`
+		ID                                string                     `json:"id"`
+		Title                             string                     `json:"title"`
+		Prompt                            string                     `json:"prompt"`
+		Cost                              int                        `json:"cost"`
+		Image                             *RewardImage               `json:"image"`
+		DefaultImage                      RewardImage                `json:"default_image"`
+		BackgroundColor                   string                     `json:"background_color"`
+		IsEnabled                         bool                       `json:"is_enabled"`
+		IsUserInputRequired               bool                       `json:"is_user_input_required"`
+		MaxPerStreamSetting               MaxPerStreamSetting        `json:"max_per_stream_setting"`
+		MaxPerUserPerStreamSetting        MaxPerUserPerStreamSetting `json:"max_per_user_per_stream_setting"`
+		GlobalCooldownSetting             GlobalCooldownSetting      `json:"global_cooldown_setting"`
+		IsPaused                          bool                       `json:"is_paused"`
+		IsInStock                         bool                       `json:"is_in_stock"`
+		ShouldRedemptionsSkipRequestQueue bool                       `json:"should_redemptions_skip_request_queue"`
+		RedemptionsRedeemedCurrentStream  *int                       `json:"redemptions_redeemed_current_stream"`
+		CooldownExpiresAt                 *time.Time                 `json:"cooldown_expires_at"`
+	}
+
+	RewardImage struct {
+		URL1x string `json:"url_1x"`
+		URL2x string `json:"url_2x"`
+		URL4x string `json:"url_4x"`
+	}
+
+	MaxPerStreamSetting struct {
+		IsEnabled    bool `json:"is_enabled"`
+		MaxPerStream int  `json:"max_per_stream"`
+	}
+
+	MaxPerUserPerStreamSetting struct {
+		IsEnabled           bool `json:"is_enabled"`
+		MaxPerUserPerStream int  `json:"max_per_user_per_stream"`
+	}
+
+	GlobalCooldownSetting struct {
+		IsEnabled             bool `json:"is_enabled"`
+		GlobalCooldownSeconds int  `json:"global_cooldown_seconds"`
+	}
+
+	CustomRewardWrapper struct {
+		Data []CustomReward `json:"data"`
+	}
+
+	CustomRewardOutput struct {
+		CustomReward     CustomReward
+		ResponseMetadata api.ResponseMetadata
+	}
+)
+
+type CreateCustomRewardInput struct {
+	BroadcasterID string `json:"-"`
+
+	Title                             string `json:"title"`
+	Cost                              int    `json:"cost"`
+	Prompt                            string `json:"prompt,omitempty"`
+	IsEnabled                         *bool  `json:"is_enabled,omitempty"`
+	BackgroundColor                   string `json:"background_color,omitempty"`
+	IsUserInputRequired               bool   `json:"is_user_input_required,omitempty"`
+	IsMaxPerStreamEnabled             bool   `json:"is_max_per_stream_enabled,omitempty"`
+	MaxPerStream                      int    `json:"max_per_stream,omitempty"`
+	IsMaxPerUserPerStreamEnabled      bool   `json:"is_max_per_user_per_stream_enabled,omitempty"`
+	MaxPerUserPerStream               int    `json:"max_per_user_per_stream,omitempty"`
+	IsGlobalCooldownEnabled           bool   `json:"is_global_cooldown_enabled,omitempty"`
+	GlobalCooldownSeconds             int    `json:"global_cooldown_seconds,omitempty"`
+	ShouldRedemptionsSkipRequestQueue bool   `json:"should_redemptions_skip_request_queue,omitempty"`
+}
+
+// CreateCustomReward creates a Custom Reward in the broadcaster’s channel. Only the
+// client that created the reward is able to update or delete it later.
+//
+// Reference: https://dev.twitch.tv/docs/api/reference/#create-custom-rewards
+//
+// Requires a user access token that includes the channel:manage:redemptions scope.
+func (r ChannelPointsResource) CreateCustomReward(
+	ctx context.Context,
+	input CreateCustomRewardInput,
+) (CustomRewardOutput, error) {
+	const resource = "channel_points/custom_rewards"
+
+	values := url.Values{}
+	values.Set("broadcaster_id", input.BroadcasterID)
+
+	req, err := httpcore.NewAPIRequest(ctx, httpcore.RequestOptions{
+		APIType:   api.TypeHelix,
+		Resource:  resource,
+		Method:    http.MethodPost,
+		URLValues: values,
+		Body:      input,
+	}, true)
+	if err != nil {
+		return CustomRewardOutput{}, err
+	}
+
+	var (
+		wrapper CustomRewardWrapper
+		output  CustomRewardOutput
+	)
+
+	metadata, err := r.client.doRequest(req, &wrapper, RequestAuthParams{
+		UserID: input.BroadcasterID,
+		Scopes: []string{"channel:manage:redemptions"},
+	})
+	output.ResponseMetadata = metadata
+
+	if err != nil {
+		return output, err
+	}
+
+	if len(wrapper.Data) != 0 {
+		output.CustomReward = wrapper.Data[0]
+	}
+
+	return output, nil
+}
+
+// UpdateCustomRewardInput describes custom reward properties to update. Only the set
+// (non-nil) fields are sent.
+type UpdateCustomRewardInput struct {
+	BroadcasterID string `json:"-"`
+	ID            string `json:"-"`
+
+	Title                             *string `json:"title,omitempty"`
+	Prompt                            *string `json:"prompt,omitempty"`
+	Cost                              *int    `json:"cost,omitempty"`
+	BackgroundColor                   *string `json:"background_color,omitempty"`
+	IsEnabled                         *bool   `json:"is_enabled,omitempty"`
+	IsUserInputRequired               *bool   `json:"is_user_input_required,omitempty"`
+	IsMaxPerStreamEnabled             *bool   `json:"is_max_per_stream_enabled,omitempty"`
+	MaxPerStream                      *int    `json:"max_per_stream,omitempty"`
+	IsMaxPerUserPerStreamEnabled      *bool   `json:"is_max_per_user_per_stream_enabled,omitempty"`
+	MaxPerUserPerStream               *int    `json:"max_per_user_per_stream,omitempty"`
+	IsGlobalCooldownEnabled           *bool   `json:"is_global_cooldown_enabled,omitempty"`
+	GlobalCooldownSeconds             *int    `json:"global_cooldown_seconds,omitempty"`
+	IsPaused                          *bool   `json:"is_paused,omitempty"`
+	ShouldRedemptionsSkipRequestQueue *bool   `json:"should_redemptions_skip_request_queue,omitempty"`
+}
+
+// UpdateCustomReward updates a custom reward. Error wrapping ErrRewardNotManageable is
+// returned if the reward was created by another client.
+//
+// Reference: https://dev.twitch.tv/docs/api/reference/#update-custom-reward
+//
+// Requires a user access token that includes the channel:manage:redemptions scope.
+func (r ChannelPointsResource) UpdateCustomReward(
+	ctx context.Context,
+	input UpdateCustomRewardInput,
+) (CustomRewardOutput, error) {
+	const resource = "channel_points/custom_rewards"
+
+	values := url.Values{}
+	values.Set("broadcaster_id", input.BroadcasterID)
+	values.Set("id", input.ID)
+
+	req, err := httpcore.NewAPIRequest(ctx, httpcore.RequestOptions{
+		APIType:   api.TypeHelix,
+		Resource:  resource,
+		Method:    http.MethodPatch,
+		URLValues: values,
+		Body:      input,
+	}, true)
+	if err != nil {
+		return CustomRewardOutput{}, err
+	}
+
+	var (
+		wrapper CustomRewardWrapper
+		output  CustomRewardOutput
+	)
+
+	metadata, err := r.client.doRequest(req, &wrapper, RequestAuthParams{
+		UserID: input.BroadcasterID,
+		Scopes: []string{"channel:manage:redemptions"},
+	})
+	output.ResponseMetadata = metadata
+
+	if err != nil {
+		return output, rewardError(metadata, err)
+	}
+
+	if len(wrapper.Data) != 0 {
+		output.CustomReward = wrapper.Data[0]
+	}
+
+	return output, nil
+}
+
+type DeleteCustomRewardInput struct {
+	BroadcasterID string
+	ID            string
+}
+
+// DeleteCustomReward deletes a custom reward that the broadcaster created. Error
+// wrapping ErrRewardNotManageable is returned if the reward was created by another
+// client.
+//
+// Reference: https://dev.twitch.tv/docs/api/reference/#delete-custom-reward
+//
+// Requires a user access token that includes the channel:manage:redemptions scope.
+func (r ChannelPointsResource) DeleteCustomReward(
+	ctx context.Context,
+	input DeleteCustomRewardInput,
+) (api.ResponseMetadata, error) {
+	const resource = "channel_points/custom_rewards"
+
+	values := url.Values{}
+	values.Set("broadcaster_id", input.BroadcasterID)
+	values.Set("id", input.ID)
+
+	req, err := httpcore.NewAPIRequest(ctx, httpcore.RequestOptions{
+		APIType:   api.TypeHelix,
+		Resource:  resource,
+		Method:    http.MethodDelete,
+		URLValues: values,
+	}, false)
+	if err != nil {
+		return api.ResponseMetadata{}, err
+	}
+
+	metadata, err := r.client.doRequest(req, nil, RequestAuthParams{
+		UserID: input.BroadcasterID,
+		Scopes: []string{"channel:manage:redemptions"},
+	})
+	if err != nil {
+		return metadata, rewardError(metadata, err)
+	}
+
+	return metadata, nil
+}
+
+// rewardOwnershipMessage is a part of the documented 403 message for the reward created
+// by another client ("The ID in the Client-Id header must match the client ID used to
+// create the custom reward.") in lower case.
+const rewardOwnershipMessage = "client id used to create"
+
+// rewardError wraps ErrRewardNotManageable around err if Twitch rejected the request
+// because the reward was created by another client. Other errors (e.g. 403 for the
+// broadcaster that is not a partner or affiliate) are returned as is.
+func rewardError(metadata api.ResponseMetadata, err error) error {
+	if metadata.StatusCode != http.StatusForbidden ||
+		!strings.Contains(strings.ToLower(metadata.TwitchMessage), rewardOwnershipMessage) {
+		return err
+	}
+
+	return fmt.Errorf("%w: %w", RewardNotManageableError(metadata.TwitchMessage), err)
+}
+
+type (
+	GetCustomRewardsInput struct {
+		BroadcasterID string
+		IDs           []string
+
+		// OnlyManageableRewards limits rewards to the ones created by the current client.
+		OnlyManageableRewards bool
+	}
+
+	GetCustomRewardsOutput struct {
+		CustomRewards    []CustomReward `json:"data"`
+		ResponseMetadata api.ResponseMetadata
+	}
+)
+
+// GetCustomRewards gets a list of custom rewards that the specified broadcaster created.
+//
+// Reference: https://dev.twitch.tv/docs/api/reference/#get-custom-reward
+//
+// Requires a user access token that includes the channel:read:redemptions or
+// channel:manage:redemptions scope.
+func (r ChannelPointsResource) GetCustomRewards(
+	ctx context.Context,
+	input GetCustomRewardsInput,
+) (GetCustomRewardsOutput, error) {
+	const resource = "channel_points/custom_rewards"
+
+	if len(input.IDs) > maxCustomRewardsPerRequest {
+		return GetCustomRewardsOutput{}, TooManyItemsError("IDs", maxCustomRewardsPerRequest)
+	}
+
+	values := url.Values{}
+	values.Set("broadcaster_id", input.BroadcasterID)
+	addQuery(values, "id", input.IDs)
+
+	if input.OnlyManageableRewards {
+		values.Set("only_manageable_rewards", "true")
+	}
+
+	req, err := httpcore.NewAPIRequest(ctx, httpcore.RequestOptions{
+		APIType:   api.TypeHelix,
+		Resource:  resource,
+		Method:    http.MethodGet,
+		URLValues: values,
+	}, false)
+	if err != nil {
+		return GetCustomRewardsOutput{}, err
+	}
+
+	var output GetCustomRewardsOutput
+
+	metadata, err := r.client.doRequest(req, &output, RequestAuthParams{
+		UserID: input.BroadcasterID,
+		Scopes: []string{"channel:read:redemptions"},
+	})
+	output.ResponseMetadata = metadata
+
+	if err != nil {
+		return output, err
+	}
+
+	return output, nil
+}
+
+// RedemptionStatus is a status of the custom reward redemption.
+type RedemptionStatus string
+
+const (
+	RedemptionStatusUnfulfilled RedemptionStatus = "UNFULFILLED"
+	RedemptionStatusFulfilled   RedemptionStatus = "FULFILLED"
+	RedemptionStatusCanceled    RedemptionStatus = "CANCELED"
+)
+
+// RedemptionSort is an order of the redemptions in the list.
+type RedemptionSort string
+
+const (
+	RedemptionSortOldest RedemptionSort = "OLDEST"
+	RedemptionSortNewest RedemptionSort = "NEWEST"
+)
+
+type (
+	Redemption struct {
+		BroadcasterID    string           `json:"broadcaster_id"`
+		BroadcasterLogin string           `json:"broadcaster_login"`
+		BroadcasterName  string           `json:"broadcaster_name"`
+		ID               string           `json:"id"`
+		UserID           string           `json:"user_id"`
+		UserLogin        string           `json:"user_login"`
+		UserName         string           `json:"user_name"`
+		UserInput        string           `json:"user_input"`
+		Status           RedemptionStatus `json:"status"`
+		RedeemedAt       time.Time        `json:"redeemed_at"`
+		Reward           RedemptionReward `json:"reward"`
+	}
+
+	RedemptionReward struct {
+		ID     string `json:"id"`
+		Title  string `json:"title"`
+		Prompt string `json:"prompt"`
+		Cost   int    `json:"cost"`
+	}
+
+	GetCustomRewardRedemptionsInput struct {
+		BroadcasterID string
+		RewardID      string
+
+		// Status is required if IDs are not set.
+		Status RedemptionStatus
+		IDs    []string
+		Sort   RedemptionSort
+		First  int
+		After  string
+	}
+
+	CustomRewardRedemptionsOutput struct {
+		Redemptions      []Redemption `json:"data"`
+		Pagination       Pagination   `json:"pagination"`
+		ResponseMetadata api.ResponseMetadata
+	}
+)
+
+// GetCustomRewardRedemptions gets a list of redemptions for the specified custom reward.
+// The reward must be created by the same client.
+//
+// Reference: https://dev.twitch.tv/docs/api/reference/#get-custom-reward-redemption
+//
+// Requires a user access token that includes the channel:read:redemptions or
+// channel:manage:redemptions scope.
+func (r ChannelPointsResource) GetCustomRewardRedemptions(
+	ctx context.Context,
+	input GetCustomRewardRedemptionsInput,
+) (CustomRewardRedemptionsOutput, error) {
+	const resource = "channel_points/custom_rewards/redemptions"
+
+	if len(input.IDs) > maxRedemptionsPerRequest {
+		return CustomRewardRedemptionsOutput{}, TooManyItemsError("IDs", maxRedemptionsPerRequest)
+	}
+
+	values := url.Values{}
+	values.Set("broadcaster_id", input.BroadcasterID)
+	values.Set("reward_id", input.RewardID)
+	setQuery(values, "status", string(input.Status))
+	addQuery(values, "id", input.IDs)
+	setQuery(values, "sort", string(input.Sort))
+	setQueryInt(values, "first", input.First)
+	setQuery(values, "after", input.After)
+
+	req, err := httpcore.NewAPIRequest(ctx, httpcore.RequestOptions{
+		APIType:   api.TypeHelix,
+		Resource:  resource,
+		Method:    http.MethodGet,
+		URLValues: values,
+	}, false)
+	if err != nil {
+		return CustomRewardRedemptionsOutput{}, err
+	}
+
+	var output CustomRewardRedemptionsOutput
+
+	metadata, err := r.client.doRequest(req, &output, RequestAuthParams{
+		UserID: input.BroadcasterID,
+		Scopes: []string{"channel:read:redemptions"},
+	})
+	output.ResponseMetadata = metadata
+
+	if err != nil {
+		return output, rewardError(metadata, err)
+	}
+
+	return output, nil
+}
+
+type UpdateRedemptionStatusInput struct {
+	BroadcasterID string `json:"-"`
+	RewardID      string `json:"-"`
+
+	// IDs are IDs of the redemptions to update (up to 50). Only redemptions with
+	// RedemptionStatusUnfulfilled status can be updated.
+	IDs []string `json:"-"`
+
+	// Status is either RedemptionStatusFulfilled or RedemptionStatusCanceled.
+	Status RedemptionStatus `json:"status"`
+}
+
+// UpdateRedemptionStatus updates status of the custom reward redemptions in bulk, so
+// they can be fulfilled or canceled (points are refunded to the user then). Error
+// wrapping ErrRewardNotManageable is returned if the reward was created by another
+// client.
+//
+// Reference: https://dev.twitch.tv/docs/api/reference/#update-redemption-status
+//
+// Requires a user access token that includes the channel:manage:redemptions scope.
+func (r ChannelPointsResource) UpdateRedemptionStatus(
+	ctx context.Context,
+	input UpdateRedemptionStatusInput,
+) (CustomRewardRedemptionsOutput, error) {
+	const resource = "channel_points/custom_rewards/redemptions"
+
+	if len(input.IDs) == 0 {
+		return CustomRewardRedemptionsOutput{}, MissingInputError("IDs")
+	}
+
+	if len(input.IDs) > maxRedemptionsPerRequest {
+		return CustomRewardRedemptionsOutput{}, TooManyItemsError("IDs", maxRedemptionsPerRequest)
+	}
+
+	values := url.Values{}
+	values.Set("broadcaster_id", input.BroadcasterID)
+	values.Set("reward_id", input.RewardID)
+	addQuery(values, "id", input.IDs)
+
+	req, err := httpcore.NewAPIRequest(ctx, httpcore.RequestOptions{
+		APIType:   api.TypeHelix,
+		Resource:  resource,
+		Method:    http.MethodPatch,
+		URLValues: values,
+		Body:      input,
+	}, true)
+	if err != nil {
+		return CustomRewardRedemptionsOutput{}, err
+	}
+
+	var output CustomRewardRedemptionsOutput
+
+	metadata, err := r.client.doRequest(req, &output, RequestAuthParams{
+		UserID: input.BroadcasterID,
+		Scopes: []string{"channel:manage:redemptions"},
+	})
+	output.ResponseMetadata = metadata
+
+	if err != nil {
+		return output, rewardError(metadata, err)
+	}
+
+	return output, nil
+}
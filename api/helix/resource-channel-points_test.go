@@ -0,0 +1,72 @@
+package helix
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/kvizyx/twitchkit/http-core"
+)
+
+func TestCustomRewardForbidden(t *testing.T) {
+	tests := []struct {
+		name              string
+		message           string
+		wantNotManageable bool
+	}{
+		{
+			name:              "created by another client",
+			message:           "The ID in the Client-Id header must match the client ID used to create the custom reward.",
+			wantNotManageable: true,
+		},
+		{
+			name:    "not affiliate",
+			message: "The broadcaster is not a partner or affiliate.",
+		},
+		{
+			name:    "other client error",
+			message: "The client is not allowed to manage rewards of this broadcaster.",
+		},
+	}
+
+	requests := map[string]func(client *Client) error{
+		"update": func(client *Client) error {
+			_, err := client.ChannelPoints().UpdateCustomReward(context.Background(), UpdateCustomRewardInput{
+				BroadcasterID: "1",
+				ID:            "reward",
+			})
+
+			return err
+		},
+		"delete": func(client *Client) error {
+			_, err := client.ChannelPoints().DeleteCustomReward(context.Background(), DeleteCustomRewardInput{
+				BroadcasterID: "1",
+				ID:            "reward",
+			})
+
+			return err
+		},
+	}
+
+	for _, tt := range tests {
+		for method, request := range requests {
+			t.Run(method+" "+tt.name, func(t *testing.T) {
+				httpClient := &fakeHTTPClient{responses: []fakeResponse{{
+					status: http.StatusForbidden,
+					body:   `{"error":"Forbidden","status":403,"message":"` + tt.message + `"}`,
+				}}}
+
+				err := request(newTestClient(t, httpClient, &fakeAuthProvider{}))
+
+				if !errors.Is(err, httpcore.ErrUnsuccessfulRequest) {
+					t.Errorf("err = %v, want %v", err, httpcore.ErrUnsuccessfulRequest)
+				}
+
+				if errors.Is(err, ErrRewardNotManageable) != tt.wantNotManageable {
+					t.Errorf("err = %v, wrapping %v is %t", err, ErrRewardNotManageable, tt.wantNotManageable)
+				}
+			})
+		}
+	}
+}
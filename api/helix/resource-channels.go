@@ -0,0 +1,451 @@
+package helix
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/kvizyx/twitchkit/api"
+	"github.com/kvizyx/twitchkit/http-core"
+)
+
+const maxChannelsPerRequest = 100
+
+type ChannelsResource struct {
+	client Client
+}
+
+func (c Client) Channels() ChannelsResource {
+	return ChannelsResource{client: c}
+}
+
+type (
+	ChannelInformation struct {
+		BroadcasterID               string   `json:"broadcaster_id"`
+		BroadcasterLogin            string   `json:"broadcaster_login"`
+		BroadcasterName             string   `json:"broadcaster_name"`
+		BroadcasterLanguage         string   `json:"broadcaster_language"`
+		GameID                      string   `json:"game_id"`
+		GameName                    string   `json:"game_name"`
+		Title                       string   `json:"title"`
+		Delay                       int      `json:"delay"`
+		Tags                        []string `json:"tags"`
+		ContentClassificationLabels []string `json:"content_classification_labels"`
+		IsBrandedContent            bool     `json:"is_branded_content"`
+	}
+
+	GetChannelInformationInput struct {
+		BroadcasterIDs []string
+	}
+
+	GetChannelInformationOutput struct {
+		Channels         []ChannelInformation `json:"data"`
+		ResponseMetadata api.ResponseMetadata
+	}
+)
+
+// GetChannelInformation gets information about one or more channels.
+//
+// Reference: https://dev.twitch.tv/docs/api/reference/#get-channel-information
+//
+// Requires an app access token or user access token.
+func (r ChannelsResource) GetChannelInformation(
+	ctx context.Context,
+	input GetChannelInformationInput,
+) (GetChannelInformationOutput, error) {
+	const resource = "channels"
+
+	if len(input.BroadcasterIDs) == 0 {
+		return GetChannelInformationOutput{}, MissingInputError("BroadcasterIDs")
+	}
+
+	if len(input.BroadcasterIDs) > maxChannelsPerRequest {
+		return GetChannelInformationOutput{}, TooManyItemsError("BroadcasterIDs", maxChannelsPerRequest)
+	}
+
+	values := url.Values{}
+	addQuery(values, "broadcaster_id", input.BroadcasterIDs)
+
+	req, err := httpcore.NewAPIRequest(ctx, httpcore.RequestOptions{
+		APIType:   api.TypeHelix,
+		Resource:  resource,
+		Method:    http.MethodGet,
+		URLValues: values,
+	}, false)
+	if err != nil {
+		return GetChannelInformationOutput{}, err
+	}
+
+	var output GetChannelInformationOutput
+
+	metadata, err := r.client.doRequest(req, &output, RequestAuthParams{})
+	output.ResponseMetadata = metadata
+
+	if err != nil {
+		return output, err
+	}
+
+	return output, nil
+}
+
+type (
+	// ModifyChannelInformationInput describes channel properties to update. Only the
+	// set (non-nil) fields are sent, so zero values (e.g. empty tags) can be set
+	// explicitly with Ptr.
+	ModifyChannelInformationInput struct {
+		BroadcasterID string `json:"-"`
+
+		GameID                      *string                            `json:"game_id,omitempty"`
+		BroadcasterLanguage         *string                            `json:"broadcaster_language,omitempty"`
+		Title                       *string                            `json:"title,omitempty"`
+		Delay                       *int                               `json:"delay,omitempty"`
+		Tags                        *[]string                          `json:"tags,omitempty"`
+		ContentClassificationLabels []ContentClassificationLabelSwitch `json:"content_classification_labels,omitempty"`
+		IsBrandedContent            *bool                              `json:"is_branded_content,omitempty"`
+	}
+
+	ContentClassificationLabelSwitch struct {
+		ID        string `json:"id"`
+		IsEnabled bool   `json:"is_enabled"`
+	}
+)
+
+// ModifyChannelInformation updates a channel’s properties.
+//
+// Reference: https://dev.twitch.tv/docs/api/reference/#modify-channel-information
+//
+// Requires a user access token that includes the channel:manage:broadcast scope.
+func (r ChannelsResource) ModifyChannelInformation(
+	ctx context.Context,
+	input ModifyChannelInformationInput,
+) (api.ResponseMetadata, error) {
+	const resource = "channels"
+
+	values := url.Values{}
+	values.Set("broadcaster_id", input.BroadcasterID)
+
+	req, err := httpcore.NewAPIRequest(ctx, httpcore.RequestOptions{
+		APIType:   api.TypeHelix,
+		Resource:  resource,
+		Method:    http.MethodPatch,
+		URLValues: values,
+		Body:      input,
+	}, true)
+	if err != nil {
+		return api.ResponseMetadata{}, err
+	}
+
+	return r.client.doRequest(req, nil, RequestAuthParams{
+		UserID: input.BroadcasterID,
+		Scopes: []string{"channel:manage:broadcast"},
+	})
+}
+
+type (
+	ChannelEditor struct {
+		UserID    string    `json:"user_id"`
+		UserName  string    `json:"user_name"`
+		CreatedAt time.Time `json:"created_at"`
+	}
+
+	GetChannelEditorsOutput struct {
+		Editors          []ChannelEditor `json:"data"`
+		ResponseMetadata api.ResponseMetadata
+	}
+)
+
+// GetChannelEditors gets the broadcaster’s list editors.
+//
+// Reference: https://dev.twitch.tv/docs/api/reference/#get-channel-editors
+//
+// Requires a user access token that includes the channel:read:editors scope.
+func (r ChannelsResource) GetChannelEditors(
+	ctx context.Context,
+	broadcasterID string,
+) (GetChannelEditorsOutput, error) {
+	const resource = "channels/editors"
+
+	values := url.Values{}
+	values.Set("broadcaster_id", broadcasterID)
+
+	req, err := httpcore.NewAPIRequest(ctx, httpcore.RequestOptions{
+		APIType:   api.TypeHelix,
+		Resource:  resource,
+		Method:    http.MethodGet,
+		URLValues: values,
+	}, false)
+	if err != nil {
+		return GetChannelEditorsOutput{}, err
+	}
+
+	var output GetChannelEditorsOutput
+
+	metadata, err := r.client.doRequest(req, &output, RequestAuthParams{
+		UserID: broadcasterID,
+		Scopes: []string{"channel:read:editors"},
+	})
+	output.ResponseMetadata = metadata
+
+	if err != nil {
+		return output, err
+	}
+
+	return output, nil
+}
+
+type (
+	FollowedChannel struct {
+		BroadcasterID    string    `json:"broadcaster_id"`
+		BroadcasterLogin string    `json:"broadcaster_login"`
+		BroadcasterName  string    `json:"broadcaster_name"`
+		FollowedAt       time.Time `json:"followed_at"`
+	}
+
+	GetFollowedChannelsInput struct {
+		UserID string
+
+		// BroadcasterID is used to check whether the user follows this broadcaster.
+		BroadcasterID string
+		First         int
+		After         string
+	}
+
+	GetFollowedChannelsOutput struct {
+		FollowedChannels []FollowedChannel `json:"data"`
+		Pagination       Pagination        `json:"pagination"`
+		Total            int               `json:"total"`
+		ResponseMetadata api.ResponseMetadata
+	}
+)
+
+// GetFollowedChannels gets a list of broadcasters that the specified user follows. You
+// can also use this endpoint to see whether a user follows a specific broadcaster.
+//
+// Reference: https://dev.twitch.tv/docs/api/reference/#get-followed-channels
+//
+// Requires a user access token that includes the user:read:follows scope.
+func (r ChannelsResource) GetFollowedChannels(
+	ctx context.Context,
+	input GetFollowedChannelsInput,
+) (GetFollowedChannelsOutput, error) {
+	const resource = "channels/followed"
+
+	values := url.Values{}
+	values.Set("user_id", input.UserID)
+	setQuery(values, "broadcaster_id", input.BroadcasterID)
+	setQueryInt(values, "first", input.First)
+	setQuery(values, "after", input.After)
+
+	req, err := httpcore.NewAPIRequest(ctx, httpcore.RequestOptions{
+		APIType:   api.TypeHelix,
+		Resource:  resource,
+		Method:    http.MethodGet,
+		URLValues: values,
+	}, false)
+	if err != nil {
+		return GetFollowedChannelsOutput{}, err
+	}
+
+	var output GetFollowedChannelsOutput
+
+	metadata, err := r.client.doRequest(req, &output, RequestAuthParams{
+		UserID: input.UserID,
+		Scopes: []string{"user:read:follows"},
+	})
+	output.ResponseMetadata = metadata
+
+	if err != nil {
+		return output, err
+	}
+
+	return output, nil
+}
+
+type (
+	ChannelFollower struct {
+		UserID     string    `json:"user_id"`
+		UserLogin  string    `json:"user_login"`
+		UserName   string    `json:"user_name"`
+		FollowedAt time.Time `json:"followed_at"`
+	}
+
+	GetChannelFollowersInput struct {
+		BroadcasterID string
+
+		// ModeratorID is an ID of the broadcaster's moderator whose user access token is
+		// used for the request. If it's empty, broadcaster's token is used.
+		ModeratorID string
+
+		// UserID is used to check whether the user follows the broadcaster.
+		UserID string
+		First  int
+		After  string
+	}
+
+	GetChannelFollowersOutput struct {
+		Followers        []ChannelFollower `json:"data"`
+		Pagination       Pagination        `json:"pagination"`
+		Total            int               `json:"total"`
+		ResponseMetadata api.ResponseMetadata
+	}
+)
+
+// GetChannelFollowers gets a list of users that follow the specified broadcaster. You
+// can also use this endpoint to see whether a specific user follows the broadcaster.
+//
+// Reference: https://dev.twitch.tv/docs/api/reference/#get-channel-followers
+//
+// Requires a user access token that includes the moderator:read:followers scope. The
+// token's user must be the broadcaster or one of the broadcaster's moderators.
+func (r ChannelsResource) GetChannelFollowers(
+	ctx context.Context,
+	input GetChannelFollowersInput,
+) (GetChannelFollowersOutput, error) {
+	const resource = "channels/followers"
+
+	values := url.Values{}
+	values.Set("broadcaster_id", input.BroadcasterID)
+	setQuery(values, "user_id", input.UserID)
+	setQueryInt(values, "first", input.First)
+	setQuery(values, "after", input.After)
+
+	req, err := httpcore.NewAPIRequest(ctx, httpcore.RequestOptions{
+		APIType:   api.TypeHelix,
+		Resource:  resource,
+		Method:    http.MethodGet,
+		URLValues: values,
+	}, false)
+	if err != nil {
+		return GetChannelFollowersOutput{}, err
+	}
+
+	_, authParams := ModeratorAuthParams(input.BroadcasterID, input.ModeratorID, "moderator:read:followers")
+
+	var output GetChannelFollowersOutput
+
+	metadata, err := r.client.doRequest(req, &output, authParams)
+	output.ResponseMetadata = metadata
+
+	if err != nil {
+		return output, err
+	}
+
+	return output, nil
+}
+
+const maxVIPsPerRequest = 100
+
+type (
+	VIP struct {
+		UserID    string `json:"user_id"`
+		UserName  string `json:"user_name"`
+		UserLogin string `json:"user_login"`
+	}
+
+	GetVIPsInput struct {
+		BroadcasterID string
+		UserIDs       []string
+		First         int
+		After         string
+	}
+
+	GetVIPsOutput struct {
+		VIPs             []VIP      `json:"data"`
+		Pagination       Pagination `json:"pagination"`
+		ResponseMetadata api.ResponseMetadata
+	}
+)
+
+// GetVIPs gets a list of the broadcaster’s VIPs.
+//
+// Reference: https://dev.twitch.tv/docs/api/reference/#get-vips
+//
+// Requires a user access token that includes the channel:read:vips scope.
+func (r ChannelsResource) GetVIPs(ctx context.Context, input GetVIPsInput) (GetVIPsOutput, error) {
+	const resource = "channels/vips"
+
+	if len(input.UserIDs) > maxVIPsPerRequest {
+		return GetVIPsOutput{}, TooManyItemsError("UserIDs", maxVIPsPerRequest)
+	}
+
+	values := url.Values{}
+	values.Set("broadcaster_id", input.BroadcasterID)
+	addQuery(values, "user_id", input.UserIDs)
+	setQueryInt(values, "first", input.First)
+	setQuery(values, "after", input.After)
+
+	req, err := httpcore.NewAPIRequest(ctx, httpcore.RequestOptions{
+		APIType:   api.TypeHelix,
+		Resource:  resource,
+		Method:    http.MethodGet,
+		URLValues: values,
+	}, false)
+	if err != nil {
+		return GetVIPsOutput{}, err
+	}
+
+	var output GetVIPsOutput
+
+	metadata, err := r.client.doRequest(req, &output, RequestAuthParams{
+		UserID: input.BroadcasterID,
+		Scopes: []string{"channel:read:vips"},
+	})
+	output.ResponseMetadata = metadata
+
+	if err != nil {
+		return output, err
+	}
+
+	return output, nil
+}
+
+type ChannelVIPInput struct {
+	BroadcasterID string
+	UserID        string
+}
+
+// AddChannelVIP adds the specified user as a VIP in the broadcaster’s channel.
+//
+// Reference: https://dev.twitch.tv/docs/api/reference/#add-channel-vip
+//
+// Requires a user access token that includes the channel:manage:vips scope.
+func (r ChannelsResource) AddChannelVIP(ctx context.Context, input ChannelVIPInput) (api.ResponseMetadata, error) {
+	return r.manageChannelVIP(ctx, http.MethodPost, input)
+}
+
+// RemoveChannelVIP removes the specified user as a VIP in the broadcaster’s channel.
+//
+// Reference: https://dev.twitch.tv/docs/api/reference/#remove-channel-vip
+//
+// Requires a user access token that includes the channel:manage:vips scope.
+func (r ChannelsResource) RemoveChannelVIP(ctx context.Context, input ChannelVIPInput) (api.ResponseMetadata, error) {
+	return r.manageChannelVIP(ctx, http.MethodDelete, input)
+}
+
+func (r ChannelsResource) manageChannelVIP(
+	ctx context.Context,
+	method string,
+	input ChannelVIPInput,
+) (api.ResponseMetadata, error) {
+	const resource = "channels/vips"
+
+	values := url.Values{}
+	values.Set("broadcaster_id", input.BroadcasterID)
+	values.Set("user_id", input.UserID)
+
+	req, err := httpcore.NewAPIRequest(ctx, httpcore.RequestOptions{
+		APIType:   api.TypeHelix,
+		Resource:  resource,
+		Method:    method,
+		URLValues: values,
+	}, false)
+	if err != nil {
+		return api.ResponseMetadata{}, err
+	}
+
+	return r.client.doRequest(req, nil, RequestAuthParams{
+		UserID: input.BroadcasterID,
+		Scopes: []string{"channel:manage:vips"},
+	})
+}
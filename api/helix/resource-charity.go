@@ -0,0 +1,220 @@
+package helix
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/kvizyx/twitchkit/api"
+	"github.com/kvizyx/twitchkit/http-core"
+)
+
+type CharityResource struct {
+	client Client
+}
+
+func (c Client) Charity() CharityResource {
+	return CharityResource{client: c}
+}
+
+// CharityAmount is an exact monetary amount. The actual amount is Value divided by 10 to
+// the power of DecimalPlaces (e.g. Value 550 with DecimalPlaces 2 is 5.50), so it never
+// goes through floating point.
+type CharityAmount struct {
+	Value         int64 `json:"value"`
+	DecimalPlaces int   `json:"decimal_places"`
+
+	// Currency is an ISO-4217 three-letter currency code (e.g. USD).
+	Currency string `json:"currency"`
+}
+
+// String returns the amount as a decimal number with exactly DecimalPlaces digits after
+// the point (e.g. "5.50").
+func (a CharityAmount) String() string {
+	digits := strconv.FormatInt(a.Value, 10)
+
+	sign := ""
+	if a.Value < 0 {
+		sign, digits = "-", digits[1:]
+	}
+
+	if a.DecimalPlaces <= 0 {
+		return sign + digits
+	}
+
+	if len(digits) <= a.DecimalPlaces {
+		digits = strings.Repeat("0", a.DecimalPlaces-len(digits)+1) + digits
+	}
+
+	point := len(digits) - a.DecimalPlaces
+
+	return sign + digits[:point] + "." + digits[point:]
+}
+
+// Add returns sum of the amounts with the greater of their decimal places.
+// ErrCurrencyMismatch is returned if amounts are in different currencies.
+func (a CharityAmount) Add(b CharityAmount) (CharityAmount, error) {
+	if !strings.EqualFold(a.Currency, b.Currency) {
+		return CharityAmount{}, fmt.Errorf("%w: %s and %s", ErrCurrencyMismatch, a.Currency, b.Currency)
+	}
+
+	a, b = a.rescale(b.DecimalPlaces), b.rescale(a.DecimalPlaces)
+
+	return CharityAmount{
+		Value:         a.Value + b.Value,
+		DecimalPlaces: a.DecimalPlaces,
+		Currency:      a.Currency,
+	}, nil
+}
+
+// Progress returns the amount as a fraction of the target (e.g. 0.5 for a half), which
+// is meant for progress bars. It's zero if the target is not positive.
+func (a CharityAmount) Progress(target CharityAmount) float64 {
+	a, target = a.rescale(target.DecimalPlaces), target.rescale(a.DecimalPlaces)
+	if target.Value <= 0 {
+		return 0
+	}
+
+	return float64(a.Value) / float64(target.Value)
+}
+
+// rescale returns the same amount with at least the given number of decimal places.
+func (a CharityAmount) rescale(decimalPlaces int) CharityAmount {
+	for a.DecimalPlaces < decimalPlaces {
+		a.Value *= 10
+		a.DecimalPlaces++
+	}
+
+	return a
+}
+
+type (
+	CharityCampaign struct {
+		ID                 string        `json:"id"`
+		BroadcasterID      string        `json:"broadcaster_id"`
+		BroadcasterLogin   string        `json:"broadcaster_login"`
+		BroadcasterName    string        `json:"broadcaster_name"`
+		CharityName        string        `json:"charity_name"`
+		CharityDescription string        `json:"charity_description"`
+		CharityLogo        string        `json:"charity_logo"`
+		CharityWebsite     string        `json:"charity_website"`
+		CurrentAmount      CharityAmount `json:"current_amount"`
+
+		// TargetAmount is nil if the broadcaster didn't set a fundraising goal.
+		TargetAmount *CharityAmount `json:"target_amount"`
+	}
+
+	GetCharityCampaignOutput struct {
+		// Campaigns is empty if the broadcaster is not running a charity campaign.
+		Campaigns        []CharityCampaign `json:"data"`
+		ResponseMetadata api.ResponseMetadata
+	}
+)
+
+// GetCharityCampaign gets information about the charity campaign that the broadcaster
+// is running.
+//
+// Reference: https://dev.twitch.tv/docs/api/reference/#get-charity-campaign
+//
+// Requires a user access token that includes the channel:read:charity scope.
+func (r CharityResource) GetCharityCampaign(
+	ctx context.Context,
+	broadcasterID string,
+) (GetCharityCampaignOutput, error) {
+	const resource = "charity/campaigns"
+
+	values := url.Values{}
+	values.Set("broadcaster_id", broadcasterID)
+
+	req, err := httpcore.NewAPIRequest(ctx, httpcore.RequestOptions{
+		APIType:   api.TypeHelix,
+		Resource:  resource,
+		Method:    http.MethodGet,
+		URLValues: values,
+	}, false)
+	if err != nil {
+		return GetCharityCampaignOutput{}, err
+	}
+
+	var output GetCharityCampaignOutput
+
+	metadata, err := r.client.doRequest(req, &output, RequestAuthParams{
+		UserID: broadcasterID,
+		Scopes: []string{"channel:read:charity"},
+	})
+	output.ResponseMetadata = metadata
+
+	if err != nil {
+		return output, err
+	}
+
+	return output, nil
+}
+
+type (
+	CharityDonation struct {
+		ID         string        `json:"id"`
+		CampaignID string        `json:"campaign_id"`
+		UserID     string        `json:"user_id"`
+		UserLogin  string        `json:"user_login"`
+		UserName   string        `json:"user_name"`
+		Amount     CharityAmount `json:"amount"`
+	}
+
+	GetCharityCampaignDonationsInput struct {
+		BroadcasterID string
+		First         int
+		After         string
+	}
+
+	GetCharityCampaignDonationsOutput struct {
+		Donations        []CharityDonation `json:"data"`
+		Pagination       Pagination        `json:"pagination"`
+		ResponseMetadata api.ResponseMetadata
+	}
+)
+
+// GetCharityCampaignDonations gets the list of donations that users have made to the
+// broadcaster’s active charity campaign.
+//
+// Reference: https://dev.twitch.tv/docs/api/reference/#get-charity-campaign-donations
+//
+// Requires a user access token that includes the channel:read:charity scope.
+func (r CharityResource) GetCharityCampaignDonations(
+	ctx context.Context,
+	input GetCharityCampaignDonationsInput,
+) (GetCharityCampaignDonationsOutput, error) {
+	const resource = "charity/donations"
+
+	values := url.Values{}
+	values.Set("broadcaster_id", input.BroadcasterID)
+	setQueryInt(values, "first", input.First)
+	setQuery(values, "after", input.After)
+
+	req, err := httpcore.NewAPIRequest(ctx, httpcore.RequestOptions{
+		APIType:   api.TypeHelix,
+		Resource:  resource,
+		Method:    http.MethodGet,
+		URLValues: values,
+	}, false)
+	if err != nil {
+		return GetCharityCampaignDonationsOutput{}, err
+	}
+
+	var output GetCharityCampaignDonationsOutput
+
+	metadata, err := r.client.doRequest(req, &output, RequestAuthParams{
+		UserID: input.BroadcasterID,
+		Scopes: []string{"channel:read:charity"},
+	})
+	output.ResponseMetadata = metadata
+
+	if err != nil {
+		return output, err
+	}
+
+	return output, nil
+}
@@ -3,6 +3,8 @@ package helix
 import (
 	"context"
 	"net/http"
+	"net/url"
+	"time"
 
 	"github.com/kvizyx/twitchkit/api"
 	"github.com/kvizyx/twitchkit/http-core"
@@ -67,3 +69,696 @@ func (r ChatResource) GetGlobalBadges(ctx context.Context) (GetGlobalChatBadgesO
 
 	return output, nil
 }
+
+type ChannelChatBadgesOutput struct {
+	ChatBadges       []ChatBadge `json:"data"`
+	ResponseMetadata api.ResponseMetadata
+}
+
+// GetChannelChatBadges gets the broadcaster’s list of custom chat badges.
+//
+// Reference: https://dev.twitch.tv/docs/api/reference/#get-channel-chat-badges
+//
+// Requires an app access token or user access token.
+func (r ChatResource) GetChannelChatBadges(
+	ctx context.Context,
+	broadcasterID string,
+) (ChannelChatBadgesOutput, error) {
+	const resource = "chat/badges"
+
+	values := url.Values{}
+	values.Set("broadcaster_id", broadcasterID)
+
+	req, err := httpcore.NewAPIRequest(ctx, httpcore.RequestOptions{
+		APIType:   api.TypeHelix,
+		Resource:  resource,
+		Method:    http.MethodGet,
+		URLValues: values,
+	}, false)
+	if err != nil {
+		return ChannelChatBadgesOutput{}, err
+	}
+
+	var output ChannelChatBadgesOutput
+
+	metadata, err := r.client.doRequest(req, &output, RequestAuthParams{})
+	output.ResponseMetadata = metadata
+
+	if err != nil {
+		return output, err
+	}
+
+	return output, nil
+}
+
+type (
+	SendChatMessageWrapper struct {
+		Data []SendChatMessageOutput `json:"data"`
+	}
+
+	SendChatMessageInput struct {
+		BroadcasterID        string `json:"broadcaster_id"`
+		SenderID             string `json:"sender_id"`
+		Message              string `json:"message"`
+		ReplyParentMessageID string `json:"reply_parent_message_id,omitempty"`
+	}
+
+	SendChatMessageOutput struct {
+		MessageID        string      `json:"message_id"`
+		IsSent           bool        `json:"is_sent"`
+		DropReason       *DropReason `json:"drop_reason"`
+		ResponseMetadata api.ResponseMetadata
+	}
+
+	// DropReason is a reason why the message was not sent.
+	DropReason struct {
+		Code    string `json:"code"`
+		Message string `json:"message"`
+	}
+)
+
+// SendChatMessage sends a message to the broadcaster’s chat room. If the message was
+// dropped, error wrapping ErrMessageDropped is returned along with the output that
+// contains DropReason.
+//
+// Reference: https://dev.twitch.tv/docs/api/reference/#send-chat-message
+//
+// Requires a user access token of the sender that includes the user:write:chat scope.
+func (r ChatResource) SendChatMessage(ctx context.Context, input SendChatMessageInput) (SendChatMessageOutput, error) {
+	const resource = "chat/messages"
+
+	req, err := httpcore.NewAPIRequest(ctx, httpcore.RequestOptions{
+		APIType:  api.TypeHelix,
+		Resource: resource,
+		Method:   http.MethodPost,
+		Body:     input,
+	}, true)
+	if err != nil {
+		return SendChatMessageOutput{}, err
+	}
+
+	var (
+		wrapper SendChatMessageWrapper
+		output  SendChatMessageOutput
+	)
+
+	metadata, err := r.client.doRequest(req, &wrapper, RequestAuthParams{
+		UserID: input.SenderID,
+		Scopes: []string{"user:write:chat"},
+	})
+
+	if len(wrapper.Data) != 0 {
+		output = wrapper.Data[0]
+	}
+
+	output.ResponseMetadata = metadata
+
+	if err != nil {
+		return output, err
+	}
+
+	if !output.IsSent {
+		return output, MessageDroppedError(output.DropReason)
+	}
+
+	return output, nil
+}
+
+type (
+	Chatter struct {
+		UserID    string `json:"user_id"`
+		UserLogin string `json:"user_login"`
+		UserName  string `json:"user_name"`
+	}
+
+	GetChattersInput struct {
+		BroadcasterID string
+
+		// ModeratorID is the moderator whose token is used to list the chatters.
+		ModeratorID string
+		First       int
+		After       string
+	}
+
+	GetChattersOutput struct {
+		Chatters         []Chatter  `json:"data"`
+		Pagination       Pagination `json:"pagination"`
+		Total            int        `json:"total"`
+		ResponseMetadata api.ResponseMetadata
+	}
+)
+
+// GetChatters gets the list of users that are connected to the broadcaster’s chat
+// session.
+//
+// Reference: https://dev.twitch.tv/docs/api/reference/#get-chatters
+//
+// Requires a user access token that includes the moderator:read:chatters scope.
+func (r ChatResource) GetChatters(ctx context.Context, input GetChattersInput) (GetChattersOutput, error) {
+	const resource = "chat/chatters"
+
+	moderatorID, authParams := ModeratorAuthParams(
+		input.BroadcasterID,
+		input.ModeratorID,
+		"moderator:read:chatters",
+	)
+
+	values := url.Values{}
+	values.Set("broadcaster_id", input.BroadcasterID)
+	values.Set("moderator_id", moderatorID)
+	setQueryInt(values, "first", input.First)
+	setQuery(values, "after", input.After)
+
+	req, err := httpcore.NewAPIRequest(ctx, httpcore.RequestOptions{
+		APIType:   api.TypeHelix,
+		Resource:  resource,
+		Method:    http.MethodGet,
+		URLValues: values,
+	}, false)
+	if err != nil {
+		return GetChattersOutput{}, err
+	}
+
+	var output GetChattersOutput
+
+	metadata, err := r.client.doRequest(req, &output, authParams)
+	output.ResponseMetadata = metadata
+
+	if err != nil {
+		return output, err
+	}
+
+	return output, nil
+}
+
+type (
+	Emote struct {
+		ID        string      `json:"id"`
+		Name      string      `json:"name"`
+		Images    EmoteImages `json:"images"`
+		Format    []string    `json:"format"`
+		Scale     []string    `json:"scale"`
+		ThemeMode []string    `json:"theme_mode"`
+
+		// Tier, EmoteType and EmoteSetID are set for channel emotes and emote sets.
+		Tier       string `json:"tier"`
+		EmoteType  string `json:"emote_type"`
+		EmoteSetID string `json:"emote_set_id"`
+
+		// OwnerID is set for emote sets.
+		OwnerID string `json:"owner_id"`
+	}
+
+	EmoteImages struct {
+		URL1x string `json:"url_1x"`
+		URL2x string `json:"url_2x"`
+		URL4x string `json:"url_4x"`
+	}
+
+	GetEmotesOutput struct {
+		Emotes []Emote `json:"data"`
+
+		// Template is a templated URL to use for fetching any emote in any format, scale
+		// and theme mode.
+		Template         string `json:"template"`
+		ResponseMetadata api.ResponseMetadata
+	}
+)
+
+// GetChannelEmotes gets the broadcaster’s list of custom emotes.
+//
+// Reference: https://dev.twitch.tv/docs/api/reference/#get-channel-emotes
+//
+// Requires an app access token or user access token.
+func (r ChatResource) GetChannelEmotes(ctx context.Context, broadcasterID string) (GetEmotesOutput, error) {
+	const resource = "chat/emotes"
+
+	values := url.Values{}
+	values.Set("broadcaster_id", broadcasterID)
+
+	return r.getEmotes(ctx, resource, values)
+}
+
+// GetGlobalEmotes gets the list of global emotes.
+//
+// Reference: https://dev.twitch.tv/docs/api/reference/#get-global-emotes
+//
+// Requires an app access token or user access token.
+func (r ChatResource) GetGlobalEmotes(ctx context.Context) (GetEmotesOutput, error) {
+	const resource = "chat/emotes/global"
+
+	return r.getEmotes(ctx, resource, nil)
+}
+
+const maxEmoteSetsPerRequest = 25
+
+// GetEmoteSets gets emotes for one or more specified emote sets.
+//
+// Reference: https://dev.twitch.tv/docs/api/reference/#get-emote-sets
+//
+// Requires an app access token or user access token.
+func (r ChatResource) GetEmoteSets(ctx context.Context, emoteSetIDs []string) (GetEmotesOutput, error) {
+	const resource = "chat/emotes/set"
+
+	if len(emoteSetIDs) > maxEmoteSetsPerRequest {
+		return GetEmotesOutput{}, TooManyItemsError("emoteSetIDs", maxEmoteSetsPerRequest)
+	}
+
+	values := url.Values{}
+	addQuery(values, "emote_set_id", emoteSetIDs)
+
+	return r.getEmotes(ctx, resource, values)
+}
+
+func (r ChatResource) getEmotes(ctx context.Context, resource string, values url.Values) (GetEmotesOutput, error) {
+	req, err := httpcore.NewAPIRequest(ctx, httpcore.RequestOptions{
+		APIType:   api.TypeHelix,
+		Resource:  resource,
+		Method:    http.MethodGet,
+		URLValues: values,
+	}, false)
+	if err != nil {
+		return GetEmotesOutput{}, err
+	}
+
+	var output GetEmotesOutput
+
+	metadata, err := r.client.doRequest(req, &output, RequestAuthParams{})
+	output.ResponseMetadata = metadata
+
+	if err != nil {
+		return output, err
+	}
+
+	return output, nil
+}
+
+type (
+	ChatSettings struct {
+		BroadcasterID                 string `json:"broadcaster_id"`
+		EmoteMode                     bool   `json:"emote_mode"`
+		FollowerMode                  bool   `json:"follower_mode"`
+		FollowerModeDuration          *int   `json:"follower_mode_duration"`
+		ModeratorID                   string `json:"moderator_id"`
+		NonModeratorChatDelay         bool   `json:"non_moderator_chat_delay"`
+		NonModeratorChatDelayDuration *int   `json:"non_moderator_chat_delay_duration"`
+		SlowMode                      bool   `json:"slow_mode"`
+		SlowModeWaitTime              *int   `json:"slow_mode_wait_time"`
+		SubscriberMode                bool   `json:"subscriber_mode"`
+		UniqueChatMode                bool   `json:"unique_chat_mode"`
+	}
+
+	ChatSettingsWrapper struct {
+		Data []ChatSettings `json:"data"`
+	}
+
+	GetChatSettingsInput struct {
+		BroadcasterID string
+
+		// ModeratorID is required only to get the non-moderator chat delay settings.
+		// User access token of this user is used for the request then.
+		ModeratorID string
+	}
+
+	ChatSettingsOutput struct {
+		ChatSettings
+		ResponseMetadata api.ResponseMetadata
+	}
+)
+
+// GetChatSettings gets the broadcaster’s chat settings.
+//
+// Reference: https://dev.twitch.tv/docs/api/reference/#get-chat-settings
+//
+// Requires an app access token or user access token. User access token that includes
+// the moderator:read:chat_settings scope is required if ModeratorID is set.
+func (r ChatResource) GetChatSettings(ctx context.Context, input GetChatSettingsInput) (ChatSettingsOutput, error) {
+	const resource = "chat/settings"
+
+	values := url.Values{}
+	values.Set("broadcaster_id", input.BroadcasterID)
+	setQuery(values, "moderator_id", input.ModeratorID)
+
+	req, err := httpcore.NewAPIRequest(ctx, httpcore.RequestOptions{
+		APIType:   api.TypeHelix,
+		Resource:  resource,
+		Method:    http.MethodGet,
+		URLValues: values,
+	}, false)
+	if err != nil {
+		return ChatSettingsOutput{}, err
+	}
+
+	var authParams RequestAuthParams
+	if len(input.ModeratorID) != 0 {
+		authParams = RequestAuthParams{
+			UserID: input.ModeratorID,
+			Scopes: []string{"moderator:read:chat_settings"},
+		}
+	}
+
+	var (
+		wrapper ChatSettingsWrapper
+		output  ChatSettingsOutput
+	)
+
+	metadata, err := r.client.doRequest(req, &wrapper, authParams)
+	output.ResponseMetadata = metadata
+
+	if err != nil {
+		return output, err
+	}
+
+	if len(wrapper.Data) != 0 {
+		output.ChatSettings = wrapper.Data[0]
+	}
+
+	return output, nil
+}
+
+// UpdateChatSettingsInput describes chat settings to update. Only the set (non-nil)
+// fields are sent.
+type UpdateChatSettingsInput struct {
+	BroadcasterID string `json:"-"`
+
+	// ModeratorID is the moderator who changes the settings.
+	ModeratorID string `json:"-"`
+
+	EmoteMode                     *bool `json:"emote_mode,omitempty"`
+	FollowerMode                  *bool `json:"follower_mode,omitempty"`
+	FollowerModeDuration          *int  `json:"follower_mode_duration,omitempty"`
+	NonModeratorChatDelay         *bool `json:"non_moderator_chat_delay,omitempty"`
+	NonModeratorChatDelayDuration *int  `json:"non_moderator_chat_delay_duration,omitempty"`
+	SlowMode                      *bool `json:"slow_mode,omitempty"`
+	SlowModeWaitTime              *int  `json:"slow_mode_wait_time,omitempty"`
+	SubscriberMode                *bool `json:"subscriber_mode,omitempty"`
+	UniqueChatMode                *bool `json:"unique_chat_mode,omitempty"`
+}
+
+// UpdateChatSettings updates the broadcaster’s chat settings.
+//
+// Reference: https://dev.twitch.tv/docs/api/reference/#update-chat-settings
+//
+// Requires a user access token that includes the moderator:manage:chat_settings scope.
+func (r ChatResource) UpdateChatSettings(
+	ctx context.Context,
+	input UpdateChatSettingsInput,
+) (ChatSettingsOutput, error) {
+	const resource = "chat/settings"
+
+	moderatorID, authParams := ModeratorAuthParams(
+		input.BroadcasterID,
+		input.ModeratorID,
+		"moderator:manage:chat_settings",
+	)
+
+	values := url.Values{}
+	values.Set("broadcaster_id", input.BroadcasterID)
+	values.Set("moderator_id", moderatorID)
+
+	req, err := httpcore.NewAPIRequest(ctx, httpcore.RequestOptions{
+		APIType:   api.TypeHelix,
+		Resource:  resource,
+		Method:    http.MethodPatch,
+		URLValues: values,
+		Body:      input,
+	}, true)
+	if err != nil {
+		return ChatSettingsOutput{}, err
+	}
+
+	var (
+		wrapper ChatSettingsWrapper
+		output  ChatSettingsOutput
+	)
+
+	metadata, err := r.client.doRequest(req, &wrapper, authParams)
+	output.ResponseMetadata = metadata
+
+	if err != nil {
+		return output, err
+	}
+
+	if len(wrapper.Data) != 0 {
+		output.ChatSettings = wrapper.Data[0]
+	}
+
+	return output, nil
+}
+
+// AnnouncementColor is a color used to highlight the announcement.
+type AnnouncementColor string
+
+const (
+	AnnouncementColorPrimary AnnouncementColor = "primary"
+	AnnouncementColorBlue    AnnouncementColor = "blue"
+	AnnouncementColorGreen   AnnouncementColor = "green"
+	AnnouncementColorOrange  AnnouncementColor = "orange"
+	AnnouncementColorPurple  AnnouncementColor = "purple"
+)
+
+type SendChatAnnouncementInput struct {
+	BroadcasterID string `json:"-"`
+
+	// ModeratorID is the moderator who sends the announcement.
+	ModeratorID string `json:"-"`
+
+	Message string            `json:"message"`
+	Color   AnnouncementColor `json:"color,omitempty"`
+}
+
+// SendChatAnnouncement sends an announcement to the broadcaster’s chat room.
+//
+// Reference: https://dev.twitch.tv/docs/api/reference/#send-chat-announcement
+//
+// Requires a user access token that includes the moderator:manage:announcements scope.
+func (r ChatResource) SendChatAnnouncement(
+	ctx context.Context,
+	input SendChatAnnouncementInput,
+) (api.ResponseMetadata, error) {
+	const resource = "chat/announcements"
+
+	moderatorID, authParams := ModeratorAuthParams(
+		input.BroadcasterID,
+		input.ModeratorID,
+		"moderator:manage:announcements",
+	)
+
+	values := url.Values{}
+	values.Set("broadcaster_id", input.BroadcasterID)
+	values.Set("moderator_id", moderatorID)
+
+	req, err := httpcore.NewAPIRequest(ctx, httpcore.RequestOptions{
+		APIType:   api.TypeHelix,
+		Resource:  resource,
+		Method:    http.MethodPost,
+		URLValues: values,
+		Body:      input,
+	}, true)
+	if err != nil {
+		return api.ResponseMetadata{}, err
+	}
+
+	return r.client.doRequest(req, nil, authParams)
+}
+
+type SendShoutoutInput struct {
+	FromBroadcasterID string
+	ToBroadcasterID   string
+
+	// ModeratorID is the moderator who gives the shoutout.
+	ModeratorID string
+}
+
+// SendShoutout sends a Shoutout to the specified broadcaster.
+//
+// Reference: https://dev.twitch.tv/docs/api/reference/#send-a-shoutout
+//
+// Requires a user access token that includes the moderator:manage:shoutouts scope.
+func (r ChatResource) SendShoutout(ctx context.Context, input SendShoutoutInput) (api.ResponseMetadata, error) {
+	const resource = "chat/shoutouts"
+
+	moderatorID, authParams := ModeratorAuthParams(
+		input.FromBroadcasterID,
+		input.ModeratorID,
+		"moderator:manage:shoutouts",
+	)
+
+	values := url.Values{}
+	values.Set("from_broadcaster_id", input.FromBroadcasterID)
+	values.Set("to_broadcaster_id", input.ToBroadcasterID)
+	values.Set("moderator_id", moderatorID)
+
+	req, err := httpcore.NewAPIRequest(ctx, httpcore.RequestOptions{
+		APIType:   api.TypeHelix,
+		Resource:  resource,
+		Method:    http.MethodPost,
+		URLValues: values,
+	}, false)
+	if err != nil {
+		return api.ResponseMetadata{}, err
+	}
+
+	return r.client.doRequest(req, nil, authParams)
+}
+
+const maxChatColorUsersPerRequest = 100
+
+type (
+	UserChatColor struct {
+		UserID    string `json:"user_id"`
+		UserLogin string `json:"user_login"`
+		UserName  string `json:"user_name"`
+
+		// Color is a hex color code, it's empty if the user has never set the color.
+		Color string `json:"color"`
+	}
+
+	GetUserChatColorOutput struct {
+		Colors           []UserChatColor `json:"data"`
+		ResponseMetadata api.ResponseMetadata
+	}
+)
+
+// GetUserChatColor gets the color used for the user’s name in chat.
+//
+// Reference: https://dev.twitch.tv/docs/api/reference/#get-user-chat-color
+//
+// Requires an app access token or user access token.
+func (r ChatResource) GetUserChatColor(ctx context.Context, userIDs []string) (GetUserChatColorOutput, error) {
+	const resource = "chat/color"
+
+	if len(userIDs) > maxChatColorUsersPerRequest {
+		return GetUserChatColorOutput{}, TooManyItemsError("userIDs", maxChatColorUsersPerRequest)
+	}
+
+	values := url.Values{}
+	addQuery(values, "user_id", userIDs)
+
+	req, err := httpcore.NewAPIRequest(ctx, httpcore.RequestOptions{
+		APIType:   api.TypeHelix,
+		Resource:  resource,
+		Method:    http.MethodGet,
+		URLValues: values,
+	}, false)
+	if err != nil {
+		return GetUserChatColorOutput{}, err
+	}
+
+	var output GetUserChatColorOutput
+
+	metadata, err := r.client.doRequest(req, &output, RequestAuthParams{})
+	output.ResponseMetadata = metadata
+
+	if err != nil {
+		return output, err
+	}
+
+	return output, nil
+}
+
+type UpdateUserChatColorInput struct {
+	UserID string
+
+	// Color is one of the named colors (e.g. "blue_violet") or, for Turbo and Prime
+	// users, a hex color code.
+	Color string
+}
+
+// UpdateUserChatColor updates the color used for the user’s name in chat.
+//
+// Reference: https://dev.twitch.tv/docs/api/reference/#update-user-chat-color
+//
+// Requires a user access token that includes the user:manage:chat_color scope.
+func (r ChatResource) UpdateUserChatColor(
+	ctx context.Context,
+	input UpdateUserChatColorInput,
+) (api.ResponseMetadata, error) {
+	const resource = "chat/color"
+
+	values := url.Values{}
+	values.Set("user_id", input.UserID)
+	values.Set("color", input.Color)
+
+	req, err := httpcore.NewAPIRequest(ctx, httpcore.RequestOptions{
+		APIType:   api.TypeHelix,
+		Resource:  resource,
+		Method:    http.MethodPut,
+		URLValues: values,
+	}, false)
+	if err != nil {
+		return api.ResponseMetadata{}, err
+	}
+
+	return r.client.doRequest(req, nil, RequestAuthParams{
+		UserID: input.UserID,
+		Scopes: []string{"user:manage:chat_color"},
+	})
+}
+
+type (
+	SharedChatSession struct {
+		SessionID         string                  `json:"session_id"`
+		HostBroadcasterID string                  `json:"host_broadcaster_id"`
+		Participants      []SharedChatParticipant `json:"participants"`
+		CreatedAt         time.Time               `json:"created_at"`
+		UpdatedAt         time.Time               `json:"updated_at"`
+	}
+
+	SharedChatParticipant struct {
+		BroadcasterID string `json:"broadcaster_id"`
+	}
+
+	SharedChatSessionWrapper struct {
+		Data []SharedChatSession `json:"data"`
+	}
+
+	GetSharedChatSessionOutput struct {
+		// Session is nil if the broadcaster is not in the shared chat session.
+		Session          *SharedChatSession
+		ResponseMetadata api.ResponseMetadata
+	}
+)
+
+// GetSharedChatSession gets the active shared chat session for the broadcaster.
+//
+// Reference: https://dev.twitch.tv/docs/api/reference/#get-shared-chat-session
+//
+// Requires an app access token or user access token.
+func (r ChatResource) GetSharedChatSession(
+	ctx context.Context,
+	broadcasterID string,
+) (GetSharedChatSessionOutput, error) {
+	const resource = "shared_chat/session"
+
+	values := url.Values{}
+	values.Set("broadcaster_id", broadcasterID)
+
+	req, err := httpcore.NewAPIRequest(ctx, httpcore.RequestOptions{
+		APIType:   api.TypeHelix,
+		Resource:  resource,
+		Method:    http.MethodGet,
+		URLValues: values,
+	}, false)
+	if err != nil {
+		return GetSharedChatSessionOutput{}, err
+	}
+
+	var (
+		wrapper SharedChatSessionWrapper
+		output  GetSharedChatSessionOutput
+	)
+
+	metadata, err := r.client.doRequest(req, &wrapper, RequestAuthParams{})
+	output.ResponseMetadata = metadata
+
+	if err != nil {
+		return output, err
+	}
+
+	if len(wrapper.Data) != 0 {
+		output.Session = &wrapper.Data[0]
+	}
+
+	return output, nil
+}
@@ -0,0 +1,319 @@
+package helix
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/kvizyx/twitchkit/api"
+	"github.com/kvizyx/twitchkit/http-core"
+)
+
+const (
+	maxClipsPerRequest = 100
+
+	// maxClipsPerQuery is a number of clips after which Twitch stops returning
+	// pagination cursor for the same query.
+	maxClipsPerQuery = 1000
+)
+
+type ClipsResource struct {
+	client Client
+}
+
+func (c Client) Clips() ClipsResource {
+	return ClipsResource{client: c}
+}
+
+type (
+	CreatedClip struct {
+		ID      string `json:"id"`
+		EditURL string `json:"edit_url"`
+	}
+
+	CreateClipWrapper struct {
+		Data []CreatedClip `json:"data"`
+	}
+
+	CreateClipInput struct {
+		BroadcasterID string
+
+		// UserID is an ID of the user who creates the clip. User access token of this
+		// user is used for the request. If it's empty, broadcaster's token is used.
+		UserID string
+
+		// HasDelay adds a delay before capturing the clip, so it better matches what
+		// viewers see.
+		HasDelay bool
+	}
+
+	CreateClipOutput struct {
+		Clip             CreatedClip
+		ResponseMetadata api.ResponseMetadata
+	}
+)
+
+// CreateClip creates a clip from the broadcaster’s stream. Clip creation is
+// asynchronous, so use GetClips to check whether the clip was created.
+//
+// Reference: https://dev.twitch.tv/docs/api/reference/#create-clip
+//
+// Requires a user access token that includes the clips:edit scope.
+func (r ClipsResource) CreateClip(ctx context.Context, input CreateClipInput) (CreateClipOutput, error) {
+	const resource = "clips"
+
+	values := url.Values{}
+	values.Set("broadcaster_id", input.BroadcasterID)
+
+	if input.HasDelay {
+		values.Set("has_delay", "true")
+	}
+
+	req, err := httpcore.NewAPIRequest(ctx, httpcore.RequestOptions{
+		APIType:   api.TypeHelix,
+		Resource:  resource,
+		Method:    http.MethodPost,
+		URLValues: values,
+	}, false)
+	if err != nil {
+		return CreateClipOutput{}, err
+	}
+
+	userID := input.UserID
+	if len(userID) == 0 {
+		userID = input.BroadcasterID
+	}
+
+	var (
+		wrapper CreateClipWrapper
+		output  CreateClipOutput
+	)
+
+	metadata, err := r.client.doRequest(req, &wrapper, RequestAuthParams{
+		UserID: userID,
+		Scopes: []string{"clips:edit"},
+	})
+	output.ResponseMetadata = metadata
+
+	if err != nil {
+		return output, err
+	}
+
+	if len(wrapper.Data) != 0 {
+		output.Clip = wrapper.Data[0]
+	}
+
+	return output, nil
+}
+
+type (
+	Clip struct {
+		ID              string    `json:"id"`
+		URL             string    `json:"url"`
+		EmbedURL        string    `json:"embed_url"`
+		BroadcasterID   string    `json:"broadcaster_id"`
+		BroadcasterName string    `json:"broadcaster_name"`
+		CreatorID       string    `json:"creator_id"`
+		CreatorName     string    `json:"creator_name"`
+		VideoID         string    `json:"video_id"`
+		GameID          string    `json:"game_id"`
+		Language        string    `json:"language"`
+		Title           string    `json:"title"`
+		ViewCount       int       `json:"view_count"`
+		CreatedAt       time.Time `json:"created_at"`
+		ThumbnailURL    string    `json:"thumbnail_url"`
+		Duration        float64   `json:"duration"`
+		VodOffset       *int      `json:"vod_offset"`
+		IsFeatured      bool      `json:"is_featured"`
+	}
+
+	GetClipsInput struct {
+		// BroadcasterID, GameID and IDs are mutually exclusive.
+		BroadcasterID string
+		GameID        string
+		IDs           []string
+
+		StartedAt  time.Time
+		EndedAt    time.Time
+		IsFeatured *bool
+		First      int
+		Before     string
+		After      string
+	}
+
+	GetClipsOutput struct {
+		Clips            []Clip     `json:"data"`
+		Pagination       Pagination `json:"pagination"`
+		ResponseMetadata api.ResponseMetadata
+	}
+)
+
+// GetClips gets one or more video clips that were captured from streams. Twitch returns
+// up to 1000 clips for the same query, use WalkClips to get all clips of the broadcaster.
+//
+// Reference: https://dev.twitch.tv/docs/api/reference/#get-clips
+//
+// Requires an app access token or user access token.
+func (r ClipsResource) GetClips(ctx context.Context, input GetClipsInput) (GetClipsOutput, error) {
+	const resource = "clips"
+
+	if len(input.IDs) > maxClipsPerRequest {
+		return GetClipsOutput{}, TooManyItemsError("IDs", maxClipsPerRequest)
+	}
+
+	values := url.Values{}
+	setQuery(values, "broadcaster_id", input.BroadcasterID)
+	setQuery(values, "game_id", input.GameID)
+	addQuery(values, "id", input.IDs)
+	setQueryTime(values, "started_at", input.StartedAt)
+	setQueryTime(values, "ended_at", input.EndedAt)
+	setQueryBool(values, "is_featured", input.IsFeatured)
+	setQueryInt(values, "first", input.First)
+	setQuery(values, "before", input.Before)
+	setQuery(values, "after", input.After)
+
+	req, err := httpcore.NewAPIRequest(ctx, httpcore.RequestOptions{
+		APIType:   api.TypeHelix,
+		Resource:  resource,
+		Method:    http.MethodGet,
+		URLValues: values,
+	}, false)
+	if err != nil {
+		return GetClipsOutput{}, err
+	}
+
+	var output GetClipsOutput
+
+	metadata, err := r.client.doRequest(req, &output, RequestAuthParams{})
+	output.ResponseMetadata = metadata
+
+	if err != nil {
+		return output, err
+	}
+
+	return output, nil
+}
+
+const (
+	// DefaultClipsWindow is a default size of the date window used by WalkClips.
+	DefaultClipsWindow = 7 * 24 * time.Hour
+
+	// minClipsWindow is a size of the date window that is not split anymore, even if
+	// it has more clips than Twitch returns for a single query.
+	minClipsWindow = time.Minute
+)
+
+type WalkClipsInput struct {
+	BroadcasterID string
+
+	// StartedAt is a time to walk clips from (e.g. when the broadcaster's account was
+	// created).
+	StartedAt time.Time
+
+	// EndedAt is a time to walk clips until.
+	//
+	// By default, it's the current time.
+	EndedAt time.Time
+
+	// Window is a size of the date window that clips are requested for at once. Windows
+	// with too many clips are split further automatically.
+	//
+	// By default, it's DefaultClipsWindow.
+	Window time.Duration
+}
+
+// WalkClips calls fn for every clip of the broadcaster created in the given period. It
+// works around the cap on the number of clips returned for a single query by walking
+// through the period in date windows, and splitting windows that reach the cap. Walking
+// stops on the first error returned by fn.
+func (r ClipsResource) WalkClips(ctx context.Context, input WalkClipsInput, fn func(clip Clip) error) error {
+	if input.StartedAt.IsZero() {
+		return MissingInputError("StartedAt")
+	}
+
+	if input.EndedAt.IsZero() {
+		input.EndedAt = time.Now()
+	}
+
+	if input.Window <= 0 {
+		input.Window = DefaultClipsWindow
+	}
+
+	// clips created exactly at the window boundary may be returned for both windows.
+	seen := make(map[string]struct{})
+
+	for windowStart := input.StartedAt; windowStart.Before(input.EndedAt); {
+		windowEnd := windowStart.Add(input.Window)
+		if windowEnd.After(input.EndedAt) {
+			windowEnd = input.EndedAt
+		}
+
+		if err := r.walkClipsWindow(ctx, input.BroadcasterID, windowStart, windowEnd, seen, fn); err != nil {
+			return err
+		}
+
+		windowStart = windowEnd
+	}
+
+	return nil
+}
+
+func (r ClipsResource) walkClipsWindow(
+	ctx context.Context,
+	broadcasterID string,
+	startedAt, endedAt time.Time,
+	seen map[string]struct{},
+	fn func(clip Clip) error,
+) error {
+	input := GetClipsInput{
+		BroadcasterID: broadcasterID,
+		StartedAt:     startedAt,
+		EndedAt:       endedAt,
+		First:         maxClipsPerRequest,
+	}
+
+	var clips []Clip
+
+	for {
+		output, err := r.GetClips(ctx, input)
+		if err != nil {
+			return fmt.Errorf("get clips: %w", err)
+		}
+
+		clips = append(clips, output.Clips...)
+
+		if len(output.Pagination.Cursor) == 0 || len(output.Clips) == 0 {
+			break
+		}
+
+		input.After = output.Pagination.Cursor
+	}
+
+	window := endedAt.Sub(startedAt)
+
+	if len(clips) >= maxClipsPerQuery && window > minClipsWindow {
+		middle := startedAt.Add(window / 2)
+
+		if err := r.walkClipsWindow(ctx, broadcasterID, startedAt, middle, seen, fn); err != nil {
+			return err
+		}
+
+		return r.walkClipsWindow(ctx, broadcasterID, middle, endedAt, seen, fn)
+	}
+
+	for _, clip := range clips {
+		if _, ok := seen[clip.ID]; ok {
+			continue
+		}
+
+		seen[clip.ID] = struct{}{}
+
+		if err := fn(clip); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
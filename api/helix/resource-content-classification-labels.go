@@ -0,0 +1,69 @@
+package helix
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/kvizyx/twitchkit/api"
+	"github.com/kvizyx/twitchkit/http-core"
+)
+
+type ContentClassificationLabelsResource struct {
+	client Client
+}
+
+func (c Client) ContentClassificationLabels() ContentClassificationLabelsResource {
+	return ContentClassificationLabelsResource{client: c}
+}
+
+type (
+	ContentClassificationLabel struct {
+		ID          string `json:"id"`
+		Name        string `json:"name"`
+		Description string `json:"description"`
+	}
+
+	GetContentClassificationLabelsOutput struct {
+		Labels           []ContentClassificationLabel `json:"data"`
+		ResponseMetadata api.ResponseMetadata
+	}
+)
+
+// GetContentClassificationLabels gets information about Twitch content classification
+// labels with names and descriptions in the given locale (e.g. "en-US", which is also
+// used if locale is empty).
+//
+// Reference: https://dev.twitch.tv/docs/api/reference/#get-content-classification-labels
+//
+// Requires an app access token or user access token.
+func (r ContentClassificationLabelsResource) GetContentClassificationLabels(
+	ctx context.Context,
+	locale string,
+) (GetContentClassificationLabelsOutput, error) {
+	const resource = "content_classification_labels"
+
+	values := url.Values{}
+	setQuery(values, "locale", locale)
+
+	req, err := httpcore.NewAPIRequest(ctx, httpcore.RequestOptions{
+		APIType:   api.TypeHelix,
+		Resource:  resource,
+		Method:    http.MethodGet,
+		URLValues: values,
+	}, false)
+	if err != nil {
+		return GetContentClassificationLabelsOutput{}, err
+	}
+
+	var output GetContentClassificationLabelsOutput
+
+	metadata, err := r.client.doRequest(req, &output, RequestAuthParams{})
+	output.ResponseMetadata = metadata
+
+	if err != nil {
+		return output, err
+	}
+
+	return output, nil
+}
@@ -0,0 +1,178 @@
+package helix
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/kvizyx/twitchkit/api"
+	"github.com/kvizyx/twitchkit/http-core"
+)
+
+const (
+	maxEntitlementsPerRequest       = 100
+	maxEntitlementsPerPage          = 1000
+	maxEntitlementUpdatesPerRequest = 100
+)
+
+type EntitlementsResource struct {
+	client Client
+}
+
+func (c Client) Entitlements() EntitlementsResource {
+	return EntitlementsResource{client: c}
+}
+
+// FulfillmentStatus is a status of the Drops entitlement fulfillment.
+type FulfillmentStatus string
+
+const (
+	FulfillmentStatusClaimed   FulfillmentStatus = "CLAIMED"
+	FulfillmentStatusFulfilled FulfillmentStatus = "FULFILLED"
+)
+
+// EntitlementUpdateStatus is a status of the Drops entitlement update.
+type EntitlementUpdateStatus string
+
+const (
+	EntitlementUpdateSuccess      EntitlementUpdateStatus = "SUCCESS"
+	EntitlementUpdateInvalidID    EntitlementUpdateStatus = "INVALID_ID"
+	EntitlementUpdateNotFound     EntitlementUpdateStatus = "NOT_FOUND"
+	EntitlementUpdateUnauthorized EntitlementUpdateStatus = "UNAUTHORIZED"
+	EntitlementUpdateFailed       EntitlementUpdateStatus = "UPDATE_FAILED"
+)
+
+type (
+	DropEntitlement struct {
+		ID                string            `json:"id"`
+		BenefitID         string            `json:"benefit_id"`
+		Timestamp         time.Time         `json:"timestamp"`
+		UserID            string            `json:"user_id"`
+		GameID            string            `json:"game_id"`
+		FulfillmentStatus FulfillmentStatus `json:"fulfillment_status"`
+		LastUpdated       time.Time         `json:"last_updated"`
+	}
+
+	GetDropsEntitlementsInput struct {
+		IDs               []string
+		UserID            string
+		GameID            string
+		FulfillmentStatus FulfillmentStatus
+		First             int
+		After             string
+	}
+
+	GetDropsEntitlementsOutput struct {
+		Entitlements     []DropEntitlement `json:"data"`
+		Pagination       Pagination        `json:"pagination"`
+		ResponseMetadata api.ResponseMetadata
+	}
+)
+
+// GetDropsEntitlements gets an organization’s list of entitlements that have been
+// granted to a game, a user, or both. With user access token, only entitlements of
+// that user are returned.
+//
+// Reference: https://dev.twitch.tv/docs/api/reference/#get-drops-entitlements
+//
+// Requires an app access token or user access token.
+func (r EntitlementsResource) GetDropsEntitlements(
+	ctx context.Context,
+	input GetDropsEntitlementsInput,
+) (GetDropsEntitlementsOutput, error) {
+	const resource = "entitlements/drops"
+
+	if len(input.IDs) > maxEntitlementsPerRequest {
+		return GetDropsEntitlementsOutput{}, TooManyItemsError("IDs", maxEntitlementsPerRequest)
+	}
+
+	if input.First > maxEntitlementsPerPage {
+		input.First = maxEntitlementsPerPage
+	}
+
+	values := url.Values{}
+	addQuery(values, "id", input.IDs)
+	setQuery(values, "user_id", input.UserID)
+	setQuery(values, "game_id", input.GameID)
+	setQuery(values, "fulfillment_status", string(input.FulfillmentStatus))
+	setQueryInt(values, "first", input.First)
+	setQuery(values, "after", input.After)
+
+	req, err := httpcore.NewAPIRequest(ctx, httpcore.RequestOptions{
+		APIType:   api.TypeHelix,
+		Resource:  resource,
+		Method:    http.MethodGet,
+		URLValues: values,
+	}, false)
+	if err != nil {
+		return GetDropsEntitlementsOutput{}, err
+	}
+
+	var output GetDropsEntitlementsOutput
+
+	metadata, err := r.client.doRequest(req, &output, RequestAuthParams{})
+	output.ResponseMetadata = metadata
+
+	if err != nil {
+		return output, err
+	}
+
+	return output, nil
+}
+
+type (
+	EntitlementUpdate struct {
+		Status EntitlementUpdateStatus `json:"status"`
+		IDs    []string                `json:"ids"`
+	}
+
+	UpdateDropsEntitlementsInput struct {
+		EntitlementIDs    []string          `json:"entitlement_ids"`
+		FulfillmentStatus FulfillmentStatus `json:"fulfillment_status"`
+	}
+
+	UpdateDropsEntitlementsOutput struct {
+		// Updates groups IDs of the entitlements by status of their update.
+		Updates          []EntitlementUpdate `json:"data"`
+		ResponseMetadata api.ResponseMetadata
+	}
+)
+
+// UpdateDropsEntitlements updates the Drop entitlement’s fulfillment status (up to 100
+// entitlements at once).
+//
+// Reference: https://dev.twitch.tv/docs/api/reference/#update-drops-entitlements
+//
+// Requires an app access token or user access token.
+func (r EntitlementsResource) UpdateDropsEntitlements(
+	ctx context.Context,
+	input UpdateDropsEntitlementsInput,
+) (UpdateDropsEntitlementsOutput, error) {
+	const resource = "entitlements/drops"
+
+	if len(input.EntitlementIDs) > maxEntitlementUpdatesPerRequest {
+		return UpdateDropsEntitlementsOutput{}, TooManyItemsError("EntitlementIDs", maxEntitlementUpdatesPerRequest)
+	}
+
+	req, err := httpcore.NewAPIRequest(ctx, httpcore.RequestOptions{
+		APIType:  api.TypeHelix,
+		Resource: resource,
+		Method:   http.MethodPatch,
+		Body:     input,
+	}, true)
+	if err != nil {
+		return UpdateDropsEntitlementsOutput{}, err
+	}
+
+	var output UpdateDropsEntitlementsOutput
+
+	metadata, err := r.client.doRequest(req, &output, RequestAuthParams{})
+	output.ResponseMetadata = metadata
+
+	if err != nil {
+		return output, err
+	}
+
+	return output, nil
+}
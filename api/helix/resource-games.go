@@ -0,0 +1,135 @@
+package helix
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/kvizyx/twitchkit/api"
+	"github.com/kvizyx/twitchkit/http-core"
+)
+
+const maxGamesPerRequest = 100
+
+type GamesResource struct {
+	client Client
+}
+
+func (c Client) Games() GamesResource {
+	return GamesResource{client: c}
+}
+
+type Game struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+
+	// BoxArtURL is a URL template with {width} and {height} placeholders.
+	BoxArtURL string `json:"box_art_url"`
+	IGDBID    string `json:"igdb_id"`
+}
+
+type (
+	GetTopGamesInput struct {
+		First  int
+		After  string
+		Before string
+	}
+
+	GetTopGamesOutput struct {
+		Games            []Game     `json:"data"`
+		Pagination       Pagination `json:"pagination"`
+		ResponseMetadata api.ResponseMetadata
+	}
+)
+
+// GetTopGames gets information about all broadcasts on Twitch, sorted by number of
+// viewers, with the most popular first.
+//
+// Reference: https://dev.twitch.tv/docs/api/reference/#get-top-games
+//
+// Requires an app access token or user access token.
+func (r GamesResource) GetTopGames(ctx context.Context, input GetTopGamesInput) (GetTopGamesOutput, error) {
+	const resource = "games/top"
+
+	values := url.Values{}
+	setQueryInt(values, "first", input.First)
+	setQuery(values, "after", input.After)
+	setQuery(values, "before", input.Before)
+
+	req, err := httpcore.NewAPIRequest(ctx, httpcore.RequestOptions{
+		APIType:   api.TypeHelix,
+		Resource:  resource,
+		Method:    http.MethodGet,
+		URLValues: values,
+	}, false)
+	if err != nil {
+		return GetTopGamesOutput{}, err
+	}
+
+	var output GetTopGamesOutput
+
+	metadata, err := r.client.doRequest(req, &output, RequestAuthParams{})
+	output.ResponseMetadata = metadata
+
+	if err != nil {
+		return output, err
+	}
+
+	return output, nil
+}
+
+type (
+	// GetGamesInput describes games to get. IDs, Names and IGDBIDs can be combined,
+	// but up to 100 items in total.
+	GetGamesInput struct {
+		IDs []string
+
+		// Names must match exactly the names of the games.
+		Names   []string
+		IGDBIDs []string
+	}
+
+	GetGamesOutput struct {
+		Games            []Game `json:"data"`
+		ResponseMetadata api.ResponseMetadata
+	}
+)
+
+// GetGames gets information about specified categories or games.
+//
+// Reference: https://dev.twitch.tv/docs/api/reference/#get-games
+//
+// Requires an app access token or user access token.
+func (r GamesResource) GetGames(ctx context.Context, input GetGamesInput) (GetGamesOutput, error) {
+	const resource = "games"
+
+	if len(input.IDs)+len(input.Names)+len(input.IGDBIDs) > maxGamesPerRequest {
+		return GetGamesOutput{}, TooManyItemsError("IDs, Names and IGDBIDs", maxGamesPerRequest)
+	}
+
+	values := url.Values{}
+	addQuery(values, "id", input.IDs)
+	addQuery(values, "name", input.Names)
+	addQuery(values, "igdb_id", input.IGDBIDs)
+
+	req, err := httpcore.NewAPIRequest(ctx, httpcore.RequestOptions{
+		APIType:   api.TypeHelix,
+		Resource:  resource,
+		Method:    http.MethodGet,
+		URLValues: values,
+	}, false)
+	if err != nil {
+		return GetGamesOutput{}, err
+	}
+
+	var output GetGamesOutput
+
+	metadata, err := r.client.doRequest(req, &output, RequestAuthParams{})
+	output.ResponseMetadata = metadata
+
+	if err != nil {
+		return output, err
+	}
+
+	return output, nil
+}
@@ -0,0 +1,85 @@
+package helix
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/kvizyx/twitchkit/api"
+	"github.com/kvizyx/twitchkit/http-core"
+)
+
+type GoalsResource struct {
+	client Client
+}
+
+func (c Client) Goals() GoalsResource {
+	return GoalsResource{client: c}
+}
+
+// GoalType is a type of the creator goal, which defines what its amounts count.
+type GoalType string
+
+const (
+	GoalTypeFollower             GoalType = "follower"
+	GoalTypeSubscription         GoalType = "subscription"
+	GoalTypeSubscriptionCount    GoalType = "subscription_count"
+	GoalTypeNewSubscription      GoalType = "new_subscription"
+	GoalTypeNewSubscriptionCount GoalType = "new_subscription_count"
+)
+
+type (
+	CreatorGoal struct {
+		ID               string    `json:"id"`
+		BroadcasterID    string    `json:"broadcaster_id"`
+		BroadcasterName  string    `json:"broadcaster_name"`
+		BroadcasterLogin string    `json:"broadcaster_login"`
+		Type             GoalType  `json:"type"`
+		Description      string    `json:"description"`
+		CurrentAmount    int       `json:"current_amount"`
+		TargetAmount     int       `json:"target_amount"`
+		CreatedAt        time.Time `json:"created_at"`
+	}
+
+	GetCreatorGoalsOutput struct {
+		Goals            []CreatorGoal `json:"data"`
+		ResponseMetadata api.ResponseMetadata
+	}
+)
+
+// GetCreatorGoals gets the broadcaster’s list of active goals.
+//
+// Reference: https://dev.twitch.tv/docs/api/reference/#get-creator-goals
+//
+// Requires a user access token that includes the channel:read:goals scope.
+func (r GoalsResource) GetCreatorGoals(ctx context.Context, broadcasterID string) (GetCreatorGoalsOutput, error) {
+	const resource = "goals"
+
+	values := url.Values{}
+	values.Set("broadcaster_id", broadcasterID)
+
+	req, err := httpcore.NewAPIRequest(ctx, httpcore.RequestOptions{
+		APIType:   api.TypeHelix,
+		Resource:  resource,
+		Method:    http.MethodGet,
+		URLValues: values,
+	}, false)
+	if err != nil {
+		return GetCreatorGoalsOutput{}, err
+	}
+
+	var output GetCreatorGoalsOutput
+
+	metadata, err := r.client.doRequest(req, &output, RequestAuthParams{
+		UserID: broadcasterID,
+		Scopes: []string{"channel:read:goals"},
+	})
+	output.ResponseMetadata = metadata
+
+	if err != nil {
+		return output, err
+	}
+
+	return output, nil
+}
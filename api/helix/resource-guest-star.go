@@ -0,0 +1,727 @@
+package helix
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/kvizyx/twitchkit/api"
+	"github.com/kvizyx/twitchkit/http-core"
+)
+
+// GuestStarHostSlotID is an ID of the slot that the host occupies in every session.
+const GuestStarHostSlotID = "0"
+
+type GuestStarResource struct {
+	client Client
+}
+
+func (c Client) GuestStar() GuestStarResource {
+	return GuestStarResource{client: c}
+}
+
+// guestStarAuthParams returns RequestAuthParams for Guest Star endpoints that take
+// moderator_id. Broadcaster must have channel scope for them, while moderators must
+// have moderator one.
+func guestStarAuthParams(broadcasterID, moderatorID string, manage bool) (string, RequestAuthParams) {
+	access := "read"
+	if manage {
+		access = "manage"
+	}
+
+	if len(moderatorID) == 0 || moderatorID == broadcasterID {
+		return ModeratorAuthParams(broadcasterID, moderatorID, "channel:"+access+":guest_star")
+	}
+
+	return ModeratorAuthParams(broadcasterID, moderatorID, "moderator:"+access+":guest_star")
+}
+
+// GuestStarGroupLayout is a layout of the guests in the group browser source.
+type GuestStarGroupLayout string
+
+const (
+	GuestStarLayoutTiled       GuestStarGroupLayout = "TILED_LAYOUT"
+	GuestStarLayoutScreenshare GuestStarGroupLayout = "SCREENSHARE_LAYOUT"
+	GuestStarLayoutHorizontal  GuestStarGroupLayout = "HORIZONTAL_LAYOUT"
+	GuestStarLayoutVertical    GuestStarGroupLayout = "VERTICAL_LAYOUT"
+)
+
+type (
+	GuestStarSettings struct {
+		IsModeratorSendLiveEnabled  bool                 `json:"is_moderator_send_live_enabled"`
+		SlotCount                   int                  `json:"slot_count"`
+		IsBrowserSourceAudioEnabled bool                 `json:"is_browser_source_audio_enabled"`
+		GroupLayout                 GuestStarGroupLayout `json:"group_layout"`
+		BrowserSourceToken          string               `json:"browser_source_token"`
+	}
+
+	GuestStarSettingsWrapper struct {
+		Data []GuestStarSettings `json:"data"`
+	}
+
+	GetChannelGuestStarSettingsInput struct {
+		BroadcasterID string
+
+		// ModeratorID is the moderator who reads the settings.
+		ModeratorID string
+	}
+
+	GetChannelGuestStarSettingsOutput struct {
+		Settings         GuestStarSettings
+		ResponseMetadata api.ResponseMetadata
+	}
+)
+
+// GetChannelGuestStarSettings gets the channel settings for configuration of the Guest
+// Star feature for the broadcaster.
+//
+// Reference: https://dev.twitch.tv/docs/api/reference/#get-channel-guest-star-settings
+//
+// Requires a user access token that includes the channel:read:guest_star scope (for the
+// broadcaster) or moderator:read:guest_star scope (for the moderator).
+func (r GuestStarResource) GetChannelGuestStarSettings(
+	ctx context.Context,
+	input GetChannelGuestStarSettingsInput,
+) (GetChannelGuestStarSettingsOutput, error) {
+	const resource = "guest_star/channel_settings"
+
+	moderatorID, authParams := guestStarAuthParams(input.BroadcasterID, input.ModeratorID, false)
+
+	values := url.Values{}
+	values.Set("broadcaster_id", input.BroadcasterID)
+	values.Set("moderator_id", moderatorID)
+
+	req, err := httpcore.NewAPIRequest(ctx, httpcore.RequestOptions{
+		APIType:   api.TypeHelix,
+		Resource:  resource,
+		Method:    http.MethodGet,
+		URLValues: values,
+	}, false)
+	if err != nil {
+		return GetChannelGuestStarSettingsOutput{}, err
+	}
+
+	var (
+		wrapper GuestStarSettingsWrapper
+		output  GetChannelGuestStarSettingsOutput
+	)
+
+	metadata, err := r.client.doRequest(req, &wrapper, authParams)
+	output.ResponseMetadata = metadata
+
+	if err != nil {
+		return output, err
+	}
+
+	if len(wrapper.Data) != 0 {
+		output.Settings = wrapper.Data[0]
+	}
+
+	return output, nil
+}
+
+// UpdateChannelGuestStarSettingsInput describes Guest Star settings to update. Only the
+// set (non-nil) fields are sent.
+type UpdateChannelGuestStarSettingsInput struct {
+	BroadcasterID string `json:"-"`
+
+	IsModeratorSendLiveEnabled  *bool                 `json:"is_moderator_send_live_enabled,omitempty"`
+	SlotCount                   *int                  `json:"slot_count,omitempty"`
+	IsBrowserSourceAudioEnabled *bool                 `json:"is_browser_source_audio_enabled,omitempty"`
+	GroupLayout                 *GuestStarGroupLayout `json:"group_layout,omitempty"`
+
+	// RegenerateBrowserSources regenerates browser source URLs, so the old ones stop
+	// working.
+	RegenerateBrowserSources *bool `json:"regenerate_browser_sources,omitempty"`
+}
+
+// UpdateChannelGuestStarSettings mutates the channel settings for configuration of the
+// Guest Star feature for the broadcaster.
+//
+// Reference: https://dev.twitch.tv/docs/api/reference/#update-channel-guest-star-settings
+//
+// Requires a user access token that includes the channel:manage:guest_star scope.
+func (r GuestStarResource) UpdateChannelGuestStarSettings(
+	ctx context.Context,
+	input UpdateChannelGuestStarSettingsInput,
+) (api.ResponseMetadata, error) {
+	const resource = "guest_star/channel_settings"
+
+	values := url.Values{}
+	values.Set("broadcaster_id", input.BroadcasterID)
+
+	req, err := httpcore.NewAPIRequest(ctx, httpcore.RequestOptions{
+		APIType:   api.TypeHelix,
+		Resource:  resource,
+		Method:    http.MethodPut,
+		URLValues: values,
+		Body:      input,
+	}, true)
+	if err != nil {
+		return api.ResponseMetadata{}, err
+	}
+
+	return r.client.doRequest(req, nil, RequestAuthParams{
+		UserID: input.BroadcasterID,
+		Scopes: []string{"channel:manage:guest_star"},
+	})
+}
+
+type (
+	// GuestStarMediaSettings describes audio or video of the guest. Media is on only if
+	// it's enabled by both the host and the guest, and the guest has the device for it.
+	GuestStarMediaSettings struct {
+		IsHostEnabled  bool `json:"is_host_enabled"`
+		IsGuestEnabled bool `json:"is_guest_enabled"`
+		IsAvailable    bool `json:"is_available"`
+	}
+
+	GuestStarGuest struct {
+		SlotID          string                 `json:"slot_id"`
+		IsLive          bool                   `json:"is_live"`
+		UserID          string                 `json:"user_id"`
+		UserDisplayName string                 `json:"user_display_name"`
+		UserLogin       string                 `json:"user_login"`
+		Volume          int                    `json:"volume"`
+		AssignedAt      time.Time              `json:"assigned_at"`
+		AudioSettings   GuestStarMediaSettings `json:"audio_settings"`
+		VideoSettings   GuestStarMediaSettings `json:"video_settings"`
+	}
+
+	GuestStarSession struct {
+		ID string `json:"id"`
+
+		// Guests are the host (in GuestStarHostSlotID slot) and the guests assigned to
+		// the slots.
+		Guests []GuestStarGuest `json:"guests"`
+	}
+
+	GuestStarSessionWrapper struct {
+		Data []GuestStarSession `json:"data"`
+	}
+
+	GuestStarSessionOutput struct {
+		// Session is zero if the broadcaster has no active session.
+		Session          GuestStarSession
+		ResponseMetadata api.ResponseMetadata
+	}
+)
+
+// IsOn reports whether the media is actually streamed.
+func (ms GuestStarMediaSettings) IsOn() bool {
+	return ms.IsHostEnabled && ms.IsGuestEnabled && ms.IsAvailable
+}
+
+// IsHost reports whether the guest is the host of the session.
+func (g GuestStarGuest) IsHost() bool {
+	return g.SlotID == GuestStarHostSlotID
+}
+
+// Host returns the host of the session.
+func (s GuestStarSession) Host() (GuestStarGuest, bool) {
+	return s.Slot(GuestStarHostSlotID)
+}
+
+// Slot returns the guest assigned to the slot.
+func (s GuestStarSession) Slot(slotID string) (GuestStarGuest, bool) {
+	for _, guest := range s.Guests {
+		if guest.SlotID == slotID {
+			return guest, true
+		}
+	}
+
+	return GuestStarGuest{}, false
+}
+
+// LiveGuests returns the guests (without the host) that are live on the stream.
+func (s GuestStarSession) LiveGuests() []GuestStarGuest {
+	var live []GuestStarGuest
+
+	for _, guest := range s.Guests {
+		if guest.IsLive && !guest.IsHost() {
+			live = append(live, guest)
+		}
+	}
+
+	return live
+}
+
+type GetGuestStarSessionInput struct {
+	BroadcasterID string
+
+	// ModeratorID is the moderator who reads the session.
+	ModeratorID string
+}
+
+// GetGuestStarSession gets information about an ongoing Guest Star session for a
+// particular channel.
+//
+// Reference: https://dev.twitch.tv/docs/api/reference/#get-guest-star-session
+//
+// Requires a user access token that includes the channel:read:guest_star scope (for the
+// broadcaster) or moderator:read:guest_star scope (for the moderator).
+func (r GuestStarResource) GetGuestStarSession(
+	ctx context.Context,
+	input GetGuestStarSessionInput,
+) (GuestStarSessionOutput, error) {
+	const resource = "guest_star/session"
+
+	moderatorID, authParams := guestStarAuthParams(input.BroadcasterID, input.ModeratorID, false)
+
+	values := url.Values{}
+	values.Set("broadcaster_id", input.BroadcasterID)
+	values.Set("moderator_id", moderatorID)
+
+	req, err := httpcore.NewAPIRequest(ctx, httpcore.RequestOptions{
+		APIType:   api.TypeHelix,
+		Resource:  resource,
+		Method:    http.MethodGet,
+		URLValues: values,
+	}, false)
+	if err != nil {
+		return GuestStarSessionOutput{}, err
+	}
+
+	return r.doSessionRequest(req, authParams)
+}
+
+// CreateGuestStarSession programmatically creates a Guest Star session on behalf of the
+// broadcaster. Only one session can be active at once.
+//
+// Reference: https://dev.twitch.tv/docs/api/reference/#create-guest-star-session
+//
+// Requires a user access token that includes the channel:manage:guest_star scope.
+func (r GuestStarResource) CreateGuestStarSession(
+	ctx context.Context,
+	broadcasterID string,
+) (GuestStarSessionOutput, error) {
+	const resource = "guest_star/session"
+
+	values := url.Values{}
+	values.Set("broadcaster_id", broadcasterID)
+
+	req, err := httpcore.NewAPIRequest(ctx, httpcore.RequestOptions{
+		APIType:   api.TypeHelix,
+		Resource:  resource,
+		Method:    http.MethodPost,
+		URLValues: values,
+	}, false)
+	if err != nil {
+		return GuestStarSessionOutput{}, err
+	}
+
+	return r.doSessionRequest(req, RequestAuthParams{
+		UserID: broadcasterID,
+		Scopes: []string{"channel:manage:guest_star"},
+	})
+}
+
+type EndGuestStarSessionInput struct {
+	BroadcasterID string
+	SessionID     string
+}
+
+// EndGuestStarSession programmatically ends a Guest Star session on behalf of the
+// broadcaster. All guests are removed from the call.
+//
+// Reference: https://dev.twitch.tv/docs/api/reference/#end-guest-star-session
+//
+// Requires a user access token that includes the channel:manage:guest_star scope.
+func (r GuestStarResource) EndGuestStarSession(
+	ctx context.Context,
+	input EndGuestStarSessionInput,
+) (GuestStarSessionOutput, error) {
+	const resource = "guest_star/session"
+
+	values := url.Values{}
+	values.Set("broadcaster_id", input.BroadcasterID)
+	values.Set("session_id", input.SessionID)
+
+	req, err := httpcore.NewAPIRequest(ctx, httpcore.RequestOptions{
+		APIType:   api.TypeHelix,
+		Resource:  resource,
+		Method:    http.MethodDelete,
+		URLValues: values,
+	}, false)
+	if err != nil {
+		return GuestStarSessionOutput{}, err
+	}
+
+	return r.doSessionRequest(req, RequestAuthParams{
+		UserID: input.BroadcasterID,
+		Scopes: []string{"channel:manage:guest_star"},
+	})
+}
+
+func (r GuestStarResource) doSessionRequest(
+	req *http.Request,
+	authParams RequestAuthParams,
+) (GuestStarSessionOutput, error) {
+	var (
+		wrapper GuestStarSessionWrapper
+		output  GuestStarSessionOutput
+	)
+
+	metadata, err := r.client.doRequest(req, &wrapper, authParams)
+	output.ResponseMetadata = metadata
+
+	if err != nil {
+		return output, err
+	}
+
+	if len(wrapper.Data) != 0 {
+		output.Session = wrapper.Data[0]
+	}
+
+	return output, nil
+}
+
+// GuestStarInviteStatus is a status of the guest invited to the session.
+type GuestStarInviteStatus string
+
+const (
+	GuestStarInviteInvited  GuestStarInviteStatus = "INVITED"
+	GuestStarInviteAccepted GuestStarInviteStatus = "ACCEPTED"
+	GuestStarInviteReady    GuestStarInviteStatus = "READY"
+)
+
+type (
+	GuestStarInvite struct {
+		UserID           string                `json:"user_id"`
+		InvitedAt        time.Time             `json:"invited_at"`
+		Status           GuestStarInviteStatus `json:"status"`
+		IsVideoEnabled   bool                  `json:"is_video_enabled"`
+		IsAudioEnabled   bool                  `json:"is_audio_enabled"`
+		IsVideoAvailable bool                  `json:"is_video_available"`
+		IsAudioAvailable bool                  `json:"is_audio_available"`
+	}
+
+	GetGuestStarInvitesInput struct {
+		BroadcasterID string
+
+		// ModeratorID is the moderator who reads the invites.
+		ModeratorID string
+		SessionID   string
+	}
+
+	GetGuestStarInvitesOutput struct {
+		Invites          []GuestStarInvite `json:"data"`
+		ResponseMetadata api.ResponseMetadata
+	}
+)
+
+// GetGuestStarInvites provides the caller with a list of pending invites to a Guest Star
+// session, including the invitee’s ready status while joining the waiting room.
+//
+// Reference: https://dev.twitch.tv/docs/api/reference/#get-guest-star-invites
+//
+// Requires a user access token that includes the channel:read:guest_star scope (for the
+// broadcaster) or moderator:read:guest_star scope (for the moderator).
+func (r GuestStarResource) GetGuestStarInvites(
+	ctx context.Context,
+	input GetGuestStarInvitesInput,
+) (GetGuestStarInvitesOutput, error) {
+	const resource = "guest_star/invites"
+
+	moderatorID, authParams := guestStarAuthParams(input.BroadcasterID, input.ModeratorID, false)
+
+	values := url.Values{}
+	values.Set("broadcaster_id", input.BroadcasterID)
+	values.Set("moderator_id", moderatorID)
+	values.Set("session_id", input.SessionID)
+
+	req, err := httpcore.NewAPIRequest(ctx, httpcore.RequestOptions{
+		APIType:   api.TypeHelix,
+		Resource:  resource,
+		Method:    http.MethodGet,
+		URLValues: values,
+	}, false)
+	if err != nil {
+		return GetGuestStarInvitesOutput{}, err
+	}
+
+	var output GetGuestStarInvitesOutput
+
+	metadata, err := r.client.doRequest(req, &output, authParams)
+	output.ResponseMetadata = metadata
+
+	if err != nil {
+		return output, err
+	}
+
+	return output, nil
+}
+
+type GuestStarInviteInput struct {
+	BroadcasterID string
+
+	// ModeratorID is the moderator who sends or revokes the invite.
+	ModeratorID string
+	SessionID   string
+	GuestID     string
+}
+
+// SendGuestStarInvite sends an invite to a specified guest on behalf of the broadcaster
+// for a Guest Star session in progress.
+//
+// Reference: https://dev.twitch.tv/docs/api/reference/#send-guest-star-invite
+//
+// Requires a user access token that includes the channel:manage:guest_star scope (for
+// the broadcaster) or moderator:manage:guest_star scope (for the moderator).
+func (r GuestStarResource) SendGuestStarInvite(
+	ctx context.Context,
+	input GuestStarInviteInput,
+) (api.ResponseMetadata, error) {
+	return r.doInviteRequest(ctx, http.MethodPost, input)
+}
+
+// DeleteGuestStarInvite revokes a previously sent invite for a Guest Star session.
+//
+// Reference: https://dev.twitch.tv/docs/api/reference/#delete-guest-star-invite
+//
+// Requires a user access token that includes the channel:manage:guest_star scope (for
+// the broadcaster) or moderator:manage:guest_star scope (for the moderator).
+func (r GuestStarResource) DeleteGuestStarInvite(
+	ctx context.Context,
+	input GuestStarInviteInput,
+) (api.ResponseMetadata, error) {
+	return r.doInviteRequest(ctx, http.MethodDelete, input)
+}
+
+func (r GuestStarResource) doInviteRequest(
+	ctx context.Context,
+	method string,
+	input GuestStarInviteInput,
+) (api.ResponseMetadata, error) {
+	const resource = "guest_star/invites"
+
+	moderatorID, authParams := guestStarAuthParams(input.BroadcasterID, input.ModeratorID, true)
+
+	values := url.Values{}
+	values.Set("broadcaster_id", input.BroadcasterID)
+	values.Set("moderator_id", moderatorID)
+	values.Set("session_id", input.SessionID)
+	values.Set("guest_id", input.GuestID)
+
+	req, err := httpcore.NewAPIRequest(ctx, httpcore.RequestOptions{
+		APIType:   api.TypeHelix,
+		Resource:  resource,
+		Method:    method,
+		URLValues: values,
+	}, false)
+	if err != nil {
+		return api.ResponseMetadata{}, err
+	}
+
+	return r.client.doRequest(req, nil, authParams)
+}
+
+type AssignGuestStarSlotInput struct {
+	BroadcasterID string
+
+	// ModeratorID is the moderator who assigns the guest to the slot.
+	ModeratorID string
+	SessionID   string
+	GuestID     string
+	SlotID      string
+}
+
+// AssignGuestStarSlot allows a previously invited guest to be assigned a visible slot
+// within the session. The guest must be in READY invite status.
+//
+// Reference: https://dev.twitch.tv/docs/api/reference/#assign-guest-star-slot
+//
+// Requires a user access token that includes the channel:manage:guest_star scope (for
+// the broadcaster) or moderator:manage:guest_star scope (for the moderator).
+func (r GuestStarResource) AssignGuestStarSlot(
+	ctx context.Context,
+	input AssignGuestStarSlotInput,
+) (api.ResponseMetadata, error) {
+	const resource = "guest_star/slot"
+
+	moderatorID, authParams := guestStarAuthParams(input.BroadcasterID, input.ModeratorID, true)
+
+	values := url.Values{}
+	values.Set("broadcaster_id", input.BroadcasterID)
+	values.Set("moderator_id", moderatorID)
+	values.Set("session_id", input.SessionID)
+	values.Set("guest_id", input.GuestID)
+	values.Set("slot_id", input.SlotID)
+
+	req, err := httpcore.NewAPIRequest(ctx, httpcore.RequestOptions{
+		APIType:   api.TypeHelix,
+		Resource:  resource,
+		Method:    http.MethodPost,
+		URLValues: values,
+	}, false)
+	if err != nil {
+		return api.ResponseMetadata{}, err
+	}
+
+	return r.client.doRequest(req, nil, authParams)
+}
+
+type UpdateGuestStarSlotInput struct {
+	BroadcasterID string
+
+	// ModeratorID is the moderator who moves the guest.
+	ModeratorID  string
+	SessionID    string
+	SourceSlotID string
+
+	// DestinationSlotID is a slot to move the guest to. If it's occupied, guests in
+	// both slots are swapped.
+	DestinationSlotID string
+}
+
+// UpdateGuestStarSlot allows a user to update the assigned slot for a particular user
+// within the active Guest Star session.
+//
+// Reference: https://dev.twitch.tv/docs/api/reference/#update-guest-star-slot
+//
+// Requires a user access token that includes the channel:manage:guest_star scope (for
+// the broadcaster) or moderator:manage:guest_star scope (for the moderator).
+func (r GuestStarResource) UpdateGuestStarSlot(
+	ctx context.Context,
+	input UpdateGuestStarSlotInput,
+) (api.ResponseMetadata, error) {
+	const resource = "guest_star/slot"
+
+	moderatorID, authParams := guestStarAuthParams(input.BroadcasterID, input.ModeratorID, true)
+
+	values := url.Values{}
+	values.Set("broadcaster_id", input.BroadcasterID)
+	values.Set("moderator_id", moderatorID)
+	values.Set("session_id", input.SessionID)
+	values.Set("source_slot_id", input.SourceSlotID)
+	setQuery(values, "destination_slot_id", input.DestinationSlotID)
+
+	req, err := httpcore.NewAPIRequest(ctx, httpcore.RequestOptions{
+		APIType:   api.TypeHelix,
+		Resource:  resource,
+		Method:    http.MethodPatch,
+		URLValues: values,
+	}, false)
+	if err != nil {
+		return api.ResponseMetadata{}, err
+	}
+
+	return r.client.doRequest(req, nil, authParams)
+}
+
+type DeleteGuestStarSlotInput struct {
+	BroadcasterID string
+
+	// ModeratorID is the moderator who removes the guest from the slot.
+	ModeratorID string
+	SessionID   string
+	GuestID     string
+	SlotID      string
+
+	// ShouldReinviteGuest puts the guest back into the invite queue after removing them
+	// from the slot.
+	ShouldReinviteGuest bool
+}
+
+// DeleteGuestStarSlot allows a caller to remove a slot assignment from a user
+// participating in an active Guest Star session.
+//
+// Reference: https://dev.twitch.tv/docs/api/reference/#delete-guest-star-slot
+//
+// Requires a user access token that includes the channel:manage:guest_star scope (for
+// the broadcaster) or moderator:manage:guest_star scope (for the moderator).
+func (r GuestStarResource) DeleteGuestStarSlot(
+	ctx context.Context,
+	input DeleteGuestStarSlotInput,
+) (api.ResponseMetadata, error) {
+	const resource = "guest_star/slot"
+
+	moderatorID, authParams := guestStarAuthParams(input.BroadcasterID, input.ModeratorID, true)
+
+	values := url.Values{}
+	values.Set("broadcaster_id", input.BroadcasterID)
+	values.Set("moderator_id", moderatorID)
+	values.Set("session_id", input.SessionID)
+	values.Set("guest_id", input.GuestID)
+	values.Set("slot_id", input.SlotID)
+
+	if input.ShouldReinviteGuest {
+		values.Set("should_reinvite_guest", "true")
+	}
+
+	req, err := httpcore.NewAPIRequest(ctx, httpcore.RequestOptions{
+		APIType:   api.TypeHelix,
+		Resource:  resource,
+		Method:    http.MethodDelete,
+		URLValues: values,
+	}, false)
+	if err != nil {
+		return api.ResponseMetadata{}, err
+	}
+
+	return r.client.doRequest(req, nil, authParams)
+}
+
+// UpdateGuestStarSlotSettingsInput describes slot settings to update. Only the set
+// (non-nil) fields are sent.
+type UpdateGuestStarSlotSettingsInput struct {
+	BroadcasterID string
+
+	// ModeratorID is the moderator who changes the slot settings.
+	ModeratorID string
+	SessionID   string
+	SlotID      string
+
+	IsAudioEnabled *bool
+	IsVideoEnabled *bool
+
+	// IsLive puts the guest live on the stream (or takes them off it).
+	IsLive *bool
+
+	// Volume is a volume of the guest from 0 to 100.
+	Volume *int
+}
+
+// UpdateGuestStarSlotSettings allows a user to update slot settings for a particular
+// guest within a Guest Star session, such as audio and video, and whether the guest is
+// live.
+//
+// Reference: https://dev.twitch.tv/docs/api/reference/#update-guest-star-slot-settings
+//
+// Requires a user access token that includes the channel:manage:guest_star scope (for
+// the broadcaster) or moderator:manage:guest_star scope (for the moderator).
+func (r GuestStarResource) UpdateGuestStarSlotSettings(
+	ctx context.Context,
+	input UpdateGuestStarSlotSettingsInput,
+) (api.ResponseMetadata, error) {
+	const resource = "guest_star/slot_settings"
+
+	moderatorID, authParams := guestStarAuthParams(input.BroadcasterID, input.ModeratorID, true)
+
+	values := url.Values{}
+	values.Set("broadcaster_id", input.BroadcasterID)
+	values.Set("moderator_id", moderatorID)
+	values.Set("session_id", input.SessionID)
+	values.Set("slot_id", input.SlotID)
+	setQueryBool(values, "is_audio_enabled", input.IsAudioEnabled)
+	setQueryBool(values, "is_video_enabled", input.IsVideoEnabled)
+	setQueryBool(values, "is_live", input.IsLive)
+
+	if input.Volume != nil {
+		values.Set("volume", strconv.Itoa(*input.Volume))
+	}
+
+	req, err := httpcore.NewAPIRequest(ctx, httpcore.RequestOptions{
+		APIType:   api.TypeHelix,
+		Resource:  resource,
+		Method:    http.MethodPatch,
+		URLValues: values,
+	}, false)
+	if err != nil {
+		return api.ResponseMetadata{}, err
+	}
+
+	return r.client.doRequest(req, nil, authParams)
+}
@@ -0,0 +1,106 @@
+package helix
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/kvizyx/twitchkit/api"
+	"github.com/kvizyx/twitchkit/http-core"
+)
+
+type HypeTrainResource struct {
+	client Client
+}
+
+func (c Client) HypeTrain() HypeTrainResource {
+	return HypeTrainResource{client: c}
+}
+
+type (
+	HypeTrainContribution struct {
+		// Total is a number of Bits, or a value of subscriptions (500, 1000 or 2500 per
+		// tier 1, 2 or 3 subscription).
+		Total int `json:"total"`
+
+		// Type is BITS, SUBS or OTHER.
+		Type string `json:"type"`
+		User string `json:"user"`
+	}
+
+	HypeTrainEventData struct {
+		ID               string                  `json:"id"`
+		BroadcasterID    string                  `json:"broadcaster_id"`
+		CooldownEndTime  time.Time               `json:"cooldown_end_time"`
+		ExpiresAt        time.Time               `json:"expires_at"`
+		Goal             int                     `json:"goal"`
+		LastContribution HypeTrainContribution   `json:"last_contribution"`
+		Level            int                     `json:"level"`
+		StartedAt        time.Time               `json:"started_at"`
+		TopContributions []HypeTrainContribution `json:"top_contributions"`
+		Total            int                     `json:"total"`
+	}
+
+	HypeTrainEvent struct {
+		ID             string             `json:"id"`
+		EventType      string             `json:"event_type"`
+		EventTimestamp time.Time          `json:"event_timestamp"`
+		Version        string             `json:"version"`
+		EventData      HypeTrainEventData `json:"event_data"`
+	}
+
+	GetHypeTrainEventsInput struct {
+		BroadcasterID string
+		First         int
+		After         string
+	}
+
+	GetHypeTrainEventsOutput struct {
+		Events           []HypeTrainEvent `json:"data"`
+		Pagination       Pagination       `json:"pagination"`
+		ResponseMetadata api.ResponseMetadata
+	}
+)
+
+// GetHypeTrainEvents gets information about the broadcaster’s current or most recent
+// Hype Train event, most recent first.
+//
+// Reference: https://dev.twitch.tv/docs/api/reference/#get-hype-train-events
+//
+// Requires a user access token that includes the channel:read:hype_train scope.
+func (r HypeTrainResource) GetHypeTrainEvents(
+	ctx context.Context,
+	input GetHypeTrainEventsInput,
+) (GetHypeTrainEventsOutput, error) {
+	const resource = "hypetrain/events"
+
+	values := url.Values{}
+	values.Set("broadcaster_id", input.BroadcasterID)
+	setQueryInt(values, "first", input.First)
+	setQuery(values, "after", input.After)
+
+	req, err := httpcore.NewAPIRequest(ctx, httpcore.RequestOptions{
+		APIType:   api.TypeHelix,
+		Resource:  resource,
+		Method:    http.MethodGet,
+		URLValues: values,
+	}, false)
+	if err != nil {
+		return GetHypeTrainEventsOutput{}, err
+	}
+
+	var output GetHypeTrainEventsOutput
+
+	metadata, err := r.client.doRequest(req, &output, RequestAuthParams{
+		UserID: input.BroadcasterID,
+		Scopes: []string{"channel:read:hype_train"},
+	})
+	output.ResponseMetadata = metadata
+
+	if err != nil {
+		return output, err
+	}
+
+	return output, nil
+}
@@ -0,0 +1,516 @@
+package helix
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/kvizyx/twitchkit/api"
+	"github.com/kvizyx/twitchkit/http-core"
+)
+
+const maxModeratorsPerRequest = 100
+
+type (
+	Moderator struct {
+		UserID    string `json:"user_id"`
+		UserLogin string `json:"user_login"`
+		UserName  string `json:"user_name"`
+	}
+
+	GetModeratorsInput struct {
+		BroadcasterID string
+		UserIDs       []string
+		First         int
+		After         string
+	}
+
+	GetModeratorsOutput struct {
+		Moderators       []Moderator `json:"data"`
+		Pagination       Pagination  `json:"pagination"`
+		ResponseMetadata api.ResponseMetadata
+	}
+)
+
+// GetModerators gets all users allowed to moderate the broadcaster’s chat room.
+//
+// Reference: https://dev.twitch.tv/docs/api/reference/#get-moderators
+//
+// Requires a user access token that includes the moderation:read or channel:manage:moderators scope.
+func (r ModerationResource) GetModerators(ctx context.Context, input GetModeratorsInput) (GetModeratorsOutput, error) {
+	const resource = "moderation/moderators"
+
+	if len(input.UserIDs) > maxModeratorsPerRequest {
+		return GetModeratorsOutput{}, TooManyItemsError("UserIDs", maxModeratorsPerRequest)
+	}
+
+	values := url.Values{}
+	values.Set("broadcaster_id", input.BroadcasterID)
+	addQuery(values, "user_id", input.UserIDs)
+	setQueryInt(values, "first", input.First)
+	setQuery(values, "after", input.After)
+
+	req, err := httpcore.NewAPIRequest(ctx, httpcore.RequestOptions{
+		APIType:   api.TypeHelix,
+		Resource:  resource,
+		Method:    http.MethodGet,
+		URLValues: values,
+	}, false)
+	if err != nil {
+		return GetModeratorsOutput{}, err
+	}
+
+	var output GetModeratorsOutput
+
+	metadata, err := r.client.doRequest(req, &output, RequestAuthParams{
+		UserID:    input.BroadcasterID,
+		AnyScopes: []string{"moderation:read", "channel:manage:moderators"},
+	})
+	output.ResponseMetadata = metadata
+
+	if err != nil {
+		return output, err
+	}
+
+	return output, nil
+}
+
+type ChannelModeratorInput struct {
+	BroadcasterID string
+	UserID        string
+}
+
+// AddChannelModerator adds a moderator to the broadcaster’s chat room.
+//
+// Reference: https://dev.twitch.tv/docs/api/reference/#add-channel-moderator
+//
+// Requires a user access token that includes the channel:manage:moderators scope.
+func (r ModerationResource) AddChannelModerator(
+	ctx context.Context,
+	input ChannelModeratorInput,
+) (api.ResponseMetadata, error) {
+	return r.manageChannelModerator(ctx, http.MethodPost, input)
+}
+
+// RemoveChannelModerator removes a moderator from the broadcaster’s chat room.
+//
+// Reference: https://dev.twitch.tv/docs/api/reference/#remove-channel-moderator
+//
+// Requires a user access token that includes the channel:manage:moderators scope.
+func (r ModerationResource) RemoveChannelModerator(
+	ctx context.Context,
+	input ChannelModeratorInput,
+) (api.ResponseMetadata, error) {
+	return r.manageChannelModerator(ctx, http.MethodDelete, input)
+}
+
+func (r ModerationResource) manageChannelModerator(
+	ctx context.Context,
+	method string,
+	input ChannelModeratorInput,
+) (api.ResponseMetadata, error) {
+	const resource = "moderation/moderators"
+
+	values := url.Values{}
+	values.Set("broadcaster_id", input.BroadcasterID)
+	values.Set("user_id", input.UserID)
+
+	req, err := httpcore.NewAPIRequest(ctx, httpcore.RequestOptions{
+		APIType:   api.TypeHelix,
+		Resource:  resource,
+		Method:    method,
+		URLValues: values,
+	}, false)
+	if err != nil {
+		return api.ResponseMetadata{}, err
+	}
+
+	return r.client.doRequest(req, nil, RequestAuthParams{
+		UserID: input.BroadcasterID,
+		Scopes: []string{"channel:manage:moderators"},
+	})
+}
+
+type (
+	ModeratedChannel struct {
+		BroadcasterID    string `json:"broadcaster_id"`
+		BroadcasterLogin string `json:"broadcaster_login"`
+		BroadcasterName  string `json:"broadcaster_name"`
+	}
+
+	GetModeratedChannelsInput struct {
+		UserID string
+		First  int
+		After  string
+	}
+
+	GetModeratedChannelsOutput struct {
+		Channels         []ModeratedChannel `json:"data"`
+		Pagination       Pagination         `json:"pagination"`
+		ResponseMetadata api.ResponseMetadata
+	}
+)
+
+// GetModeratedChannels gets a list of channels that the specified user has moderator
+// privileges in.
+//
+// Reference: https://dev.twitch.tv/docs/api/reference/#get-moderated-channels
+//
+// Requires a user access token that includes the user:read:moderated_channels scope.
+func (r ModerationResource) GetModeratedChannels(
+	ctx context.Context,
+	input GetModeratedChannelsInput,
+) (GetModeratedChannelsOutput, error) {
+	const resource = "moderation/channels"
+
+	values := url.Values{}
+	values.Set("user_id", input.UserID)
+	setQueryInt(values, "first", input.First)
+	setQuery(values, "after", input.After)
+
+	req, err := httpcore.NewAPIRequest(ctx, httpcore.RequestOptions{
+		APIType:   api.TypeHelix,
+		Resource:  resource,
+		Method:    http.MethodGet,
+		URLValues: values,
+	}, false)
+	if err != nil {
+		return GetModeratedChannelsOutput{}, err
+	}
+
+	var output GetModeratedChannelsOutput
+
+	metadata, err := r.client.doRequest(req, &output, RequestAuthParams{
+		UserID: input.UserID,
+		Scopes: []string{"user:read:moderated_channels"},
+	})
+	output.ResponseMetadata = metadata
+
+	if err != nil {
+		return output, err
+	}
+
+	return output, nil
+}
+
+type (
+	ShieldModeStatus struct {
+		IsActive       bool   `json:"is_active"`
+		ModeratorID    string `json:"moderator_id"`
+		ModeratorLogin string `json:"moderator_login"`
+		ModeratorName  string `json:"moderator_name"`
+
+		// LastActivatedAt is a RFC3339 time when Shield Mode was last activated, it's
+		// empty if Shield Mode hasn't been previously activated.
+		LastActivatedAt string `json:"last_activated_at"`
+	}
+
+	ShieldModeStatusWrapper struct {
+		Data []ShieldModeStatus `json:"data"`
+	}
+
+	GetShieldModeStatusInput struct {
+		BroadcasterID string
+
+		// ModeratorID is the moderator who reads the Shield Mode status.
+		ModeratorID string
+	}
+
+	ShieldModeStatusOutput struct {
+		ShieldModeStatus
+		ResponseMetadata api.ResponseMetadata
+	}
+)
+
+// GetShieldModeStatus gets the broadcaster’s Shield Mode activation status.
+//
+// Reference: https://dev.twitch.tv/docs/api/reference/#get-shield-mode-status
+//
+// Requires a user access token that includes the moderator:read:shield_mode or
+// moderator:manage:shield_mode scope.
+func (r ModerationResource) GetShieldModeStatus(
+	ctx context.Context,
+	input GetShieldModeStatusInput,
+) (ShieldModeStatusOutput, error) {
+	const resource = "moderation/shield_mode"
+
+	moderatorID, authParams := ModeratorAuthParams(
+		input.BroadcasterID,
+		input.ModeratorID,
+		"moderator:read:shield_mode",
+	)
+
+	values := url.Values{}
+	values.Set("broadcaster_id", input.BroadcasterID)
+	values.Set("moderator_id", moderatorID)
+
+	req, err := httpcore.NewAPIRequest(ctx, httpcore.RequestOptions{
+		APIType:   api.TypeHelix,
+		Resource:  resource,
+		Method:    http.MethodGet,
+		URLValues: values,
+	}, false)
+	if err != nil {
+		return ShieldModeStatusOutput{}, err
+	}
+
+	var (
+		wrapper ShieldModeStatusWrapper
+		output  ShieldModeStatusOutput
+	)
+
+	metadata, err := r.client.doRequest(req, &wrapper, authParams)
+	output.ResponseMetadata = metadata
+
+	if err != nil {
+		return output, err
+	}
+
+	if len(wrapper.Data) != 0 {
+		output.ShieldModeStatus = wrapper.Data[0]
+	}
+
+	return output, nil
+}
+
+type UpdateShieldModeStatusInput struct {
+	BroadcasterID string `json:"-"`
+
+	// ModeratorID is the moderator who activates or deactivates Shield Mode.
+	ModeratorID string `json:"-"`
+
+	IsActive bool `json:"is_active"`
+}
+
+// UpdateShieldModeStatus activates or deactivates the broadcaster’s Shield Mode.
+//
+// Reference: https://dev.twitch.tv/docs/api/reference/#update-shield-mode-status
+//
+// Requires a user access token that includes the moderator:manage:shield_mode scope.
+func (r ModerationResource) UpdateShieldModeStatus(
+	ctx context.Context,
+	input UpdateShieldModeStatusInput,
+) (ShieldModeStatusOutput, error) {
+	const resource = "moderation/shield_mode"
+
+	moderatorID, authParams := ModeratorAuthParams(
+		input.BroadcasterID,
+		input.ModeratorID,
+		"moderator:manage:shield_mode",
+	)
+
+	values := url.Values{}
+	values.Set("broadcaster_id", input.BroadcasterID)
+	values.Set("moderator_id", moderatorID)
+
+	req, err := httpcore.NewAPIRequest(ctx, httpcore.RequestOptions{
+		APIType:   api.TypeHelix,
+		Resource:  resource,
+		Method:    http.MethodPut,
+		URLValues: values,
+		Body:      input,
+	}, true)
+	if err != nil {
+		return ShieldModeStatusOutput{}, err
+	}
+
+	var (
+		wrapper ShieldModeStatusWrapper
+		output  ShieldModeStatusOutput
+	)
+
+	metadata, err := r.client.doRequest(req, &wrapper, authParams)
+	output.ResponseMetadata = metadata
+
+	if err != nil {
+		return output, err
+	}
+
+	if len(wrapper.Data) != 0 {
+		output.ShieldModeStatus = wrapper.Data[0]
+	}
+
+	return output, nil
+}
+
+// UnbanRequestStatus is a status of the unban request.
+type UnbanRequestStatus string
+
+const (
+	UnbanRequestPending      UnbanRequestStatus = "pending"
+	UnbanRequestApproved     UnbanRequestStatus = "approved"
+	UnbanRequestDenied       UnbanRequestStatus = "denied"
+	UnbanRequestAcknowledged UnbanRequestStatus = "acknowledged"
+	UnbanRequestCanceled     UnbanRequestStatus = "canceled"
+)
+
+type (
+	UnbanRequest struct {
+		ID               string             `json:"id"`
+		BroadcasterID    string             `json:"broadcaster_id"`
+		BroadcasterName  string             `json:"broadcaster_name"`
+		BroadcasterLogin string             `json:"broadcaster_login"`
+		ModeratorID      string             `json:"moderator_id"`
+		ModeratorLogin   string             `json:"moderator_login"`
+		ModeratorName    string             `json:"moderator_name"`
+		UserID           string             `json:"user_id"`
+		UserLogin        string             `json:"user_login"`
+		UserName         string             `json:"user_name"`
+		Text             string             `json:"text"`
+		Status           UnbanRequestStatus `json:"status"`
+		CreatedAt        time.Time          `json:"created_at"`
+
+		// ResolvedAt is a RFC3339 time when the request was resolved, it's empty if the
+		// request is not resolved yet.
+		ResolvedAt     string `json:"resolved_at"`
+		ResolutionText string `json:"resolution_text"`
+	}
+
+	UnbanRequestWrapper struct {
+		Data []UnbanRequest `json:"data"`
+	}
+
+	GetUnbanRequestsInput struct {
+		BroadcasterID string
+
+		// ModeratorID is the moderator who reads the unban requests.
+		ModeratorID string
+
+		Status UnbanRequestStatus
+		UserID string
+		First  int
+		After  string
+	}
+
+	GetUnbanRequestsOutput struct {
+		UnbanRequests    []UnbanRequest `json:"data"`
+		Pagination       Pagination     `json:"pagination"`
+		ResponseMetadata api.ResponseMetadata
+	}
+)
+
+// GetUnbanRequests gets a list of unban requests for the broadcaster’s channel.
+//
+// Reference: https://dev.twitch.tv/docs/api/reference/#get-unban-requests
+//
+// Requires a user access token that includes the moderator:read:unban_requests or
+// moderator:manage:unban_requests scope.
+func (r ModerationResource) GetUnbanRequests(
+	ctx context.Context,
+	input GetUnbanRequestsInput,
+) (GetUnbanRequestsOutput, error) {
+	const resource = "moderation/unban_requests"
+
+	if len(input.Status) == 0 {
+		return GetUnbanRequestsOutput{}, MissingInputError("Status")
+	}
+
+	moderatorID, authParams := ModeratorAuthParams(
+		input.BroadcasterID,
+		input.ModeratorID,
+		"moderator:read:unban_requests",
+	)
+
+	values := url.Values{}
+	values.Set("broadcaster_id", input.BroadcasterID)
+	values.Set("moderator_id", moderatorID)
+	values.Set("status", string(input.Status))
+	setQuery(values, "user_id", input.UserID)
+	setQueryInt(values, "first", input.First)
+	setQuery(values, "after", input.After)
+
+	req, err := httpcore.NewAPIRequest(ctx, httpcore.RequestOptions{
+		APIType:   api.TypeHelix,
+		Resource:  resource,
+		Method:    http.MethodGet,
+		URLValues: values,
+	}, false)
+	if err != nil {
+		return GetUnbanRequestsOutput{}, err
+	}
+
+	var output GetUnbanRequestsOutput
+
+	metadata, err := r.client.doRequest(req, &output, authParams)
+	output.ResponseMetadata = metadata
+
+	if err != nil {
+		return output, err
+	}
+
+	return output, nil
+}
+
+type (
+	ResolveUnbanRequestInput struct {
+		BroadcasterID string
+
+		// ModeratorID is the moderator who approves or denies the request.
+		ModeratorID string
+
+		UnbanRequestID string
+
+		// Status is either UnbanRequestApproved or UnbanRequestDenied.
+		Status         UnbanRequestStatus
+		ResolutionText string
+	}
+
+	ResolveUnbanRequestOutput struct {
+		UnbanRequest     UnbanRequest
+		ResponseMetadata api.ResponseMetadata
+	}
+)
+
+// ResolveUnbanRequest approves or denies the unban request.
+//
+// Reference: https://dev.twitch.tv/docs/api/reference/#resolve-unban-requests
+//
+// Requires a user access token that includes the moderator:manage:unban_requests scope.
+func (r ModerationResource) ResolveUnbanRequest(
+	ctx context.Context,
+	input ResolveUnbanRequestInput,
+) (ResolveUnbanRequestOutput, error) {
+	const resource = "moderation/unban_requests"
+
+	moderatorID, authParams := ModeratorAuthParams(
+		input.BroadcasterID,
+		input.ModeratorID,
+		"moderator:manage:unban_requests",
+	)
+
+	values := url.Values{}
+	values.Set("broadcaster_id", input.BroadcasterID)
+	values.Set("moderator_id", moderatorID)
+	values.Set("unban_request_id", input.UnbanRequestID)
+	values.Set("status", string(input.Status))
+	setQuery(values, "resolution_text", input.ResolutionText)
+
+	req, err := httpcore.NewAPIRequest(ctx, httpcore.RequestOptions{
+		APIType:   api.TypeHelix,
+		Resource:  resource,
+		Method:    http.MethodPatch,
+		URLValues: values,
+	}, false)
+	if err != nil {
+		return ResolveUnbanRequestOutput{}, err
+	}
+
+	var (
+		wrapper UnbanRequestWrapper
+		output  ResolveUnbanRequestOutput
+	)
+
+	metadata, err := r.client.doRequest(req, &wrapper, authParams)
+	output.ResponseMetadata = metadata
+
+	if err != nil {
+		return output, err
+	}
+
+	if len(wrapper.Data) != 0 {
+		output.UnbanRequest = wrapper.Data[0]
+	}
+
+	return output, nil
+}
@@ -0,0 +1,835 @@
+package helix
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/kvizyx/twitchkit/api"
+	"github.com/kvizyx/twitchkit/http-core"
+)
+
+const (
+	maxBannedUsersPerRequest    = 100
+	maxAutoModMessagesPerStatus = 100
+)
+
+type ModerationResource struct {
+	client Client
+}
+
+func (c Client) Moderation() ModerationResource {
+	return ModerationResource{client: c}
+}
+
+type (
+	Ban struct {
+		BroadcasterID string    `json:"broadcaster_id"`
+		ModeratorID   string    `json:"moderator_id"`
+		UserID        string    `json:"user_id"`
+		CreatedAt     time.Time `json:"created_at"`
+
+		// EndTime is nil if the user is banned permanently.
+		EndTime *time.Time `json:"end_time"`
+	}
+
+	BanUserWrapper struct {
+		Data []Ban `json:"data"`
+	}
+
+	BanUserInput struct {
+		BroadcasterID string
+
+		// ModeratorID is the moderator who bans or times out the user.
+		ModeratorID string
+
+		UserID string
+
+		// Duration of the timeout in seconds (from 1 to 1209600). The user is banned
+		// permanently if it's zero.
+		Duration int
+		Reason   string
+	}
+
+	BanUserOutput struct {
+		Ban              Ban
+		ResponseMetadata api.ResponseMetadata
+	}
+
+	banUserBody struct {
+		Data banUserData `json:"data"`
+	}
+
+	banUserData struct {
+		UserID   string `json:"user_id"`
+		Duration int    `json:"duration,omitempty"`
+		Reason   string `json:"reason,omitempty"`
+	}
+)
+
+// BanUser bans a user from participating in the specified broadcaster’s chat room or
+// puts them in a timeout (if Duration is set).
+//
+// Reference: https://dev.twitch.tv/docs/api/reference/#ban-user
+//
+// Requires a user access token that includes the moderator:manage:banned_users scope.
+func (r ModerationResource) BanUser(ctx context.Context, input BanUserInput) (BanUserOutput, error) {
+	const resource = "moderation/bans"
+
+	moderatorID, authParams := ModeratorAuthParams(
+		input.BroadcasterID,
+		input.ModeratorID,
+		"moderator:manage:banned_users",
+	)
+
+	values := url.Values{}
+	values.Set("broadcaster_id", input.BroadcasterID)
+	values.Set("moderator_id", moderatorID)
+
+	req, err := httpcore.NewAPIRequest(ctx, httpcore.RequestOptions{
+		APIType:   api.TypeHelix,
+		Resource:  resource,
+		Method:    http.MethodPost,
+		URLValues: values,
+		Body: banUserBody{
+			Data: banUserData{
+				UserID:   input.UserID,
+				Duration: input.Duration,
+				Reason:   input.Reason,
+			},
+		},
+	}, true)
+	if err != nil {
+		return BanUserOutput{}, err
+	}
+
+	var (
+		wrapper BanUserWrapper
+		output  BanUserOutput
+	)
+
+	metadata, err := r.client.doRequest(req, &wrapper, authParams)
+	output.ResponseMetadata = metadata
+
+	if err != nil {
+		return output, err
+	}
+
+	if len(wrapper.Data) != 0 {
+		output.Ban = wrapper.Data[0]
+	}
+
+	return output, nil
+}
+
+type UnbanUserInput struct {
+	BroadcasterID string
+
+	// ModeratorID is the moderator who removes the ban.
+	ModeratorID string
+
+	UserID string
+}
+
+// UnbanUser removes the ban or timeout that was placed on the specified user.
+//
+// Reference: https://dev.twitch.tv/docs/api/reference/#unban-user
+//
+// Requires a user access token that includes the moderator:manage:banned_users scope.
+func (r ModerationResource) UnbanUser(ctx context.Context, input UnbanUserInput) (api.ResponseMetadata, error) {
+	const resource = "moderation/bans"
+
+	moderatorID, authParams := ModeratorAuthParams(
+		input.BroadcasterID,
+		input.ModeratorID,
+		"moderator:manage:banned_users",
+	)
+
+	values := url.Values{}
+	values.Set("broadcaster_id", input.BroadcasterID)
+	values.Set("moderator_id", moderatorID)
+	values.Set("user_id", input.UserID)
+
+	req, err := httpcore.NewAPIRequest(ctx, httpcore.RequestOptions{
+		APIType:   api.TypeHelix,
+		Resource:  resource,
+		Method:    http.MethodDelete,
+		URLValues: values,
+	}, false)
+	if err != nil {
+		return api.ResponseMetadata{}, err
+	}
+
+	return r.client.doRequest(req, nil, authParams)
+}
+
+type (
+	BannedUser struct {
+		UserID    string `json:"user_id"`
+		UserLogin string `json:"user_login"`
+		UserName  string `json:"user_name"`
+
+		// ExpiresAt is a RFC3339 time when the timeout expires, it's empty if the user
+		// is banned permanently.
+		ExpiresAt      string    `json:"expires_at"`
+		CreatedAt      time.Time `json:"created_at"`
+		Reason         string    `json:"reason"`
+		ModeratorID    string    `json:"moderator_id"`
+		ModeratorLogin string    `json:"moderator_login"`
+		ModeratorName  string    `json:"moderator_name"`
+	}
+
+	GetBannedUsersInput struct {
+		BroadcasterID string
+		UserIDs       []string
+		First         int
+		After         string
+		Before        string
+	}
+
+	GetBannedUsersOutput struct {
+		BannedUsers      []BannedUser `json:"data"`
+		Pagination       Pagination   `json:"pagination"`
+		ResponseMetadata api.ResponseMetadata
+	}
+)
+
+// GetBannedUsers gets all users that the broadcaster banned or put in a timeout.
+//
+// Reference: https://dev.twitch.tv/docs/api/reference/#get-banned-users
+//
+// Requires a user access token that includes the moderation:read or moderator:manage:banned_users scope.
+func (r ModerationResource) GetBannedUsers(
+	ctx context.Context,
+	input GetBannedUsersInput,
+) (GetBannedUsersOutput, error) {
+	const resource = "moderation/banned"
+
+	if len(input.UserIDs) > maxBannedUsersPerRequest {
+		return GetBannedUsersOutput{}, TooManyItemsError("UserIDs", maxBannedUsersPerRequest)
+	}
+
+	values := url.Values{}
+	values.Set("broadcaster_id", input.BroadcasterID)
+	addQuery(values, "user_id", input.UserIDs)
+	setQueryInt(values, "first", input.First)
+	setQuery(values, "after", input.After)
+	setQuery(values, "before", input.Before)
+
+	req, err := httpcore.NewAPIRequest(ctx, httpcore.RequestOptions{
+		APIType:   api.TypeHelix,
+		Resource:  resource,
+		Method:    http.MethodGet,
+		URLValues: values,
+	}, false)
+	if err != nil {
+		return GetBannedUsersOutput{}, err
+	}
+
+	var output GetBannedUsersOutput
+
+	metadata, err := r.client.doRequest(req, &output, RequestAuthParams{
+		UserID:    input.BroadcasterID,
+		AnyScopes: []string{"moderation:read", "moderator:manage:banned_users"},
+	})
+	output.ResponseMetadata = metadata
+
+	if err != nil {
+		return output, err
+	}
+
+	return output, nil
+}
+
+type DeleteChatMessagesInput struct {
+	BroadcasterID string
+
+	// ModeratorID is the moderator who deletes the messages.
+	ModeratorID string
+
+	// MessageID is an ID of the message to remove. All messages are removed from the
+	// chat room if it's empty.
+	MessageID string
+}
+
+// DeleteChatMessages removes a single chat message or all chat messages from the
+// broadcaster’s chat room.
+//
+// Reference: https://dev.twitch.tv/docs/api/reference/#delete-chat-messages
+//
+// Requires a user access token that includes the moderator:manage:chat_messages scope.
+func (r ModerationResource) DeleteChatMessages(
+	ctx context.Context,
+	input DeleteChatMessagesInput,
+) (api.ResponseMetadata, error) {
+	const resource = "moderation/chat"
+
+	moderatorID, authParams := ModeratorAuthParams(
+		input.BroadcasterID,
+		input.ModeratorID,
+		"moderator:manage:chat_messages",
+	)
+
+	values := url.Values{}
+	values.Set("broadcaster_id", input.BroadcasterID)
+	values.Set("moderator_id", moderatorID)
+	setQuery(values, "message_id", input.MessageID)
+
+	req, err := httpcore.NewAPIRequest(ctx, httpcore.RequestOptions{
+		APIType:   api.TypeHelix,
+		Resource:  resource,
+		Method:    http.MethodDelete,
+		URLValues: values,
+	}, false)
+	if err != nil {
+		return api.ResponseMetadata{}, err
+	}
+
+	return r.client.doRequest(req, nil, authParams)
+}
+
+type (
+	AutoModMessage struct {
+		MsgID   string `json:"msg_id"`
+		MsgText string `json:"msg_text"`
+	}
+
+	AutoModStatus struct {
+		MsgID       string `json:"msg_id"`
+		IsPermitted bool   `json:"is_permitted"`
+	}
+
+	CheckAutoModStatusInput struct {
+		BroadcasterID string
+		Messages      []AutoModMessage
+	}
+
+	CheckAutoModStatusOutput struct {
+		Statuses         []AutoModStatus `json:"data"`
+		ResponseMetadata api.ResponseMetadata
+	}
+
+	checkAutoModStatusBody struct {
+		Data []AutoModMessage `json:"data"`
+	}
+)
+
+// CheckAutoModStatus checks whether AutoMod would flag the specified message for review.
+//
+// Reference: https://dev.twitch.tv/docs/api/reference/#check-automod-status
+//
+// Requires a user access token that includes the moderation:read scope.
+func (r ModerationResource) CheckAutoModStatus(
+	ctx context.Context,
+	input CheckAutoModStatusInput,
+) (CheckAutoModStatusOutput, error) {
+	const resource = "moderation/enforcements/status"
+
+	if len(input.Messages) > maxAutoModMessagesPerStatus {
+		return CheckAutoModStatusOutput{}, TooManyItemsError("Messages", maxAutoModMessagesPerStatus)
+	}
+
+	values := url.Values{}
+	values.Set("broadcaster_id", input.BroadcasterID)
+
+	req, err := httpcore.NewAPIRequest(ctx, httpcore.RequestOptions{
+		APIType:   api.TypeHelix,
+		Resource:  resource,
+		Method:    http.MethodPost,
+		URLValues: values,
+		Body:      checkAutoModStatusBody{Data: input.Messages},
+	}, true)
+	if err != nil {
+		return CheckAutoModStatusOutput{}, err
+	}
+
+	var output CheckAutoModStatusOutput
+
+	metadata, err := r.client.doRequest(req, &output, RequestAuthParams{
+		UserID: input.BroadcasterID,
+		Scopes: []string{"moderation:read"},
+	})
+	output.ResponseMetadata = metadata
+
+	if err != nil {
+		return output, err
+	}
+
+	return output, nil
+}
+
+// AutoModAction is an action to take for a message held by AutoMod.
+type AutoModAction string
+
+const (
+	AutoModActionAllow AutoModAction = "ALLOW"
+	AutoModActionDeny  AutoModAction = "DENY"
+)
+
+type ManageHeldAutoModMessageInput struct {
+	// UserID is an ID of the moderator who is approving or denying the held message.
+	// User access token of this user is used for the request.
+	UserID string        `json:"user_id"`
+	MsgID  string        `json:"msg_id"`
+	Action AutoModAction `json:"action"`
+}
+
+// ManageHeldAutoModMessage allows or denies the message that AutoMod flagged for review.
+//
+// Reference: https://dev.twitch.tv/docs/api/reference/#manage-held-automod-messages
+//
+// Requires a user access token that includes the moderator:manage:automod scope.
+func (r ModerationResource) ManageHeldAutoModMessage(
+	ctx context.Context,
+	input ManageHeldAutoModMessageInput,
+) (api.ResponseMetadata, error) {
+	const resource = "moderation/automod/message"
+
+	req, err := httpcore.NewAPIRequest(ctx, httpcore.RequestOptions{
+		APIType:  api.TypeHelix,
+		Resource: resource,
+		Method:   http.MethodPost,
+		Body:     input,
+	}, true)
+	if err != nil {
+		return api.ResponseMetadata{}, err
+	}
+
+	return r.client.doRequest(req, nil, RequestAuthParams{
+		UserID: input.UserID,
+		Scopes: []string{"moderator:manage:automod"},
+	})
+}
+
+type (
+	AutoModSettings struct {
+		BroadcasterID           string `json:"broadcaster_id"`
+		ModeratorID             string `json:"moderator_id"`
+		OverallLevel            *int   `json:"overall_level"`
+		Disability              int    `json:"disability"`
+		Aggression              int    `json:"aggression"`
+		SexualitySexOrGender    int    `json:"sexuality_sex_or_gender"`
+		Misogyny                int    `json:"misogyny"`
+		Bullying                int    `json:"bullying"`
+		Swearing                int    `json:"swearing"`
+		RaceEthnicityOrReligion int    `json:"race_ethnicity_or_religion"`
+		SexBasedTerms           int    `json:"sex_based_terms"`
+	}
+
+	AutoModSettingsWrapper struct {
+		Data []AutoModSettings `json:"data"`
+	}
+
+	GetAutoModSettingsInput struct {
+		BroadcasterID string
+
+		// ModeratorID is the moderator who reads the AutoMod settings.
+		ModeratorID string
+	}
+
+	AutoModSettingsOutput struct {
+		AutoModSettings
+		ResponseMetadata api.ResponseMetadata
+	}
+)
+
+// GetAutoModSettings gets the broadcaster’s AutoMod settings.
+//
+// Reference: https://dev.twitch.tv/docs/api/reference/#get-automod-settings
+//
+// Requires a user access token that includes the moderator:read:automod_settings scope.
+func (r ModerationResource) GetAutoModSettings(
+	ctx context.Context,
+	input GetAutoModSettingsInput,
+) (AutoModSettingsOutput, error) {
+	const resource = "moderation/automod/settings"
+
+	moderatorID, authParams := ModeratorAuthParams(
+		input.BroadcasterID,
+		input.ModeratorID,
+		"moderator:read:automod_settings",
+	)
+
+	values := url.Values{}
+	values.Set("broadcaster_id", input.BroadcasterID)
+	values.Set("moderator_id", moderatorID)
+
+	req, err := httpcore.NewAPIRequest(ctx, httpcore.RequestOptions{
+		APIType:   api.TypeHelix,
+		Resource:  resource,
+		Method:    http.MethodGet,
+		URLValues: values,
+	}, false)
+	if err != nil {
+		return AutoModSettingsOutput{}, err
+	}
+
+	var (
+		wrapper AutoModSettingsWrapper
+		output  AutoModSettingsOutput
+	)
+
+	metadata, err := r.client.doRequest(req, &wrapper, authParams)
+	output.ResponseMetadata = metadata
+
+	if err != nil {
+		return output, err
+	}
+
+	if len(wrapper.Data) != 0 {
+		output.AutoModSettings = wrapper.Data[0]
+	}
+
+	return output, nil
+}
+
+// UpdateAutoModSettingsInput describes AutoMod settings to set. Either OverallLevel or
+// individual levels should be set, unset individual levels are reset to zero by Twitch.
+type UpdateAutoModSettingsInput struct {
+	BroadcasterID string `json:"-"`
+
+	// ModeratorID is the moderator who changes the AutoMod settings.
+	ModeratorID string `json:"-"`
+
+	OverallLevel            *int `json:"overall_level,omitempty"`
+	Disability              *int `json:"disability,omitempty"`
+	Aggression              *int `json:"aggression,omitempty"`
+	SexualitySexOrGender    *int `json:"sexuality_sex_or_gender,omitempty"`
+	Misogyny                *int `json:"misogyny,omitempty"`
+	Bullying                *int `json:"bullying,omitempty"`
+	Swearing                *int `json:"swearing,omitempty"`
+	RaceEthnicityOrReligion *int `json:"race_ethnicity_or_religion,omitempty"`
+	SexBasedTerms           *int `json:"sex_based_terms,omitempty"`
+}
+
+// UpdateAutoModSettings updates the broadcaster’s AutoMod settings.
+//
+// Reference: https://dev.twitch.tv/docs/api/reference/#update-automod-settings
+//
+// Requires a user access token that includes the moderator:manage:automod_settings scope.
+func (r ModerationResource) UpdateAutoModSettings(
+	ctx context.Context,
+	input UpdateAutoModSettingsInput,
+) (AutoModSettingsOutput, error) {
+	const resource = "moderation/automod/settings"
+
+	moderatorID, authParams := ModeratorAuthParams(
+		input.BroadcasterID,
+		input.ModeratorID,
+		"moderator:manage:automod_settings",
+	)
+
+	values := url.Values{}
+	values.Set("broadcaster_id", input.BroadcasterID)
+	values.Set("moderator_id", moderatorID)
+
+	req, err := httpcore.NewAPIRequest(ctx, httpcore.RequestOptions{
+		APIType:   api.TypeHelix,
+		Resource:  resource,
+		Method:    http.MethodPut,
+		URLValues: values,
+		Body:      input,
+	}, true)
+	if err != nil {
+		return AutoModSettingsOutput{}, err
+	}
+
+	var (
+		wrapper AutoModSettingsWrapper
+		output  AutoModSettingsOutput
+	)
+
+	metadata, err := r.client.doRequest(req, &wrapper, authParams)
+	output.ResponseMetadata = metadata
+
+	if err != nil {
+		return output, err
+	}
+
+	if len(wrapper.Data) != 0 {
+		output.AutoModSettings = wrapper.Data[0]
+	}
+
+	return output, nil
+}
+
+type (
+	BlockedTerm struct {
+		BroadcasterID string    `json:"broadcaster_id"`
+		ModeratorID   string    `json:"moderator_id"`
+		ID            string    `json:"id"`
+		Text          string    `json:"text"`
+		CreatedAt     time.Time `json:"created_at"`
+		UpdatedAt     time.Time `json:"updated_at"`
+
+		// ExpiresAt is nil if the term is blocked permanently.
+		ExpiresAt *time.Time `json:"expires_at"`
+	}
+
+	BlockedTermWrapper struct {
+		Data []BlockedTerm `json:"data"`
+	}
+
+	GetBlockedTermsInput struct {
+		BroadcasterID string
+
+		// ModeratorID is the moderator who reads the blocked terms.
+		ModeratorID string
+		First       int
+		After       string
+	}
+
+	GetBlockedTermsOutput struct {
+		BlockedTerms     []BlockedTerm `json:"data"`
+		Pagination       Pagination    `json:"pagination"`
+		ResponseMetadata api.ResponseMetadata
+	}
+)
+
+// GetBlockedTerms gets the broadcaster’s list of non-private, blocked words or phrases.
+//
+// Reference: https://dev.twitch.tv/docs/api/reference/#get-blocked-terms
+//
+// Requires a user access token that includes the moderator:read:blocked_terms scope.
+func (r ModerationResource) GetBlockedTerms(
+	ctx context.Context,
+	input GetBlockedTermsInput,
+) (GetBlockedTermsOutput, error) {
+	const resource = "moderation/blocked_terms"
+
+	moderatorID, authParams := ModeratorAuthParams(
+		input.BroadcasterID,
+		input.ModeratorID,
+		"moderator:read:blocked_terms",
+	)
+
+	values := url.Values{}
+	values.Set("broadcaster_id", input.BroadcasterID)
+	values.Set("moderator_id", moderatorID)
+	setQueryInt(values, "first", input.First)
+	setQuery(values, "after", input.After)
+
+	req, err := httpcore.NewAPIRequest(ctx, httpcore.RequestOptions{
+		APIType:   api.TypeHelix,
+		Resource:  resource,
+		Method:    http.MethodGet,
+		URLValues: values,
+	}, false)
+	if err != nil {
+		return GetBlockedTermsOutput{}, err
+	}
+
+	var output GetBlockedTermsOutput
+
+	metadata, err := r.client.doRequest(req, &output, authParams)
+	output.ResponseMetadata = metadata
+
+	if err != nil {
+		return output, err
+	}
+
+	return output, nil
+}
+
+type (
+	AddBlockedTermInput struct {
+		BroadcasterID string `json:"-"`
+
+		// ModeratorID is the moderator who adds the term.
+		ModeratorID string `json:"-"`
+
+		// Text is a word or phrase to block from being used in the broadcaster’s chat
+		// room (from 2 to 500 characters).
+		Text string `json:"text"`
+	}
+
+	AddBlockedTermOutput struct {
+		BlockedTerm      BlockedTerm
+		ResponseMetadata api.ResponseMetadata
+	}
+)
+
+// AddBlockedTerm adds a word or phrase to the broadcaster’s list of blocked terms.
+//
+// Reference: https://dev.twitch.tv/docs/api/reference/#add-blocked-term
+//
+// Requires a user access token that includes the moderator:manage:blocked_terms scope.
+func (r ModerationResource) AddBlockedTerm(ctx context.Context, input AddBlockedTermInput) (AddBlockedTermOutput, error) {
+	const resource = "moderation/blocked_terms"
+
+	moderatorID, authParams := ModeratorAuthParams(
+		input.BroadcasterID,
+		input.ModeratorID,
+		"moderator:manage:blocked_terms",
+	)
+
+	values := url.Values{}
+	values.Set("broadcaster_id", input.BroadcasterID)
+	values.Set("moderator_id", moderatorID)
+
+	req, err := httpcore.NewAPIRequest(ctx, httpcore.RequestOptions{
+		APIType:   api.TypeHelix,
+		Resource:  resource,
+		Method:    http.MethodPost,
+		URLValues: values,
+		Body:      input,
+	}, true)
+	if err != nil {
+		return AddBlockedTermOutput{}, err
+	}
+
+	var (
+		wrapper BlockedTermWrapper
+		output  AddBlockedTermOutput
+	)
+
+	metadata, err := r.client.doRequest(req, &wrapper, authParams)
+	output.ResponseMetadata = metadata
+
+	if err != nil {
+		return output, err
+	}
+
+	if len(wrapper.Data) != 0 {
+		output.BlockedTerm = wrapper.Data[0]
+	}
+
+	return output, nil
+}
+
+type RemoveBlockedTermInput struct {
+	BroadcasterID string
+
+	// ModeratorID is the moderator who removes the term.
+	ModeratorID string
+
+	ID string
+}
+
+// RemoveBlockedTerm removes the word or phrase from the broadcaster’s list of blocked
+// terms.
+//
+// Reference: https://dev.twitch.tv/docs/api/reference/#remove-blocked-term
+//
+// Requires a user access token that includes the moderator:manage:blocked_terms scope.
+func (r ModerationResource) RemoveBlockedTerm(
+	ctx context.Context,
+	input RemoveBlockedTermInput,
+) (api.ResponseMetadata, error) {
+	const resource = "moderation/blocked_terms"
+
+	moderatorID, authParams := ModeratorAuthParams(
+		input.BroadcasterID,
+		input.ModeratorID,
+		"moderator:manage:blocked_terms",
+	)
+
+	values := url.Values{}
+	values.Set("broadcaster_id", input.BroadcasterID)
+	values.Set("moderator_id", moderatorID)
+	values.Set("id", input.ID)
+
+	req, err := httpcore.NewAPIRequest(ctx, httpcore.RequestOptions{
+		APIType:   api.TypeHelix,
+		Resource:  resource,
+		Method:    http.MethodDelete,
+		URLValues: values,
+	}, false)
+	if err != nil {
+		return api.ResponseMetadata{}, err
+	}
+
+	return r.client.doRequest(req, nil, authParams)
+}
+
+type (
+	Warning struct {
+		BroadcasterID string `json:"broadcaster_id"`
+		UserID        string `json:"user_id"`
+		ModeratorID   string `json:"moderator_id"`
+		Reason        string `json:"reason"`
+	}
+
+	WarnChatUserWrapper struct {
+		Data []Warning `json:"data"`
+	}
+
+	WarnChatUserInput struct {
+		BroadcasterID string
+
+		// ModeratorID is the moderator who warns the user.
+		ModeratorID string
+
+		UserID string
+		Reason string
+	}
+
+	WarnChatUserOutput struct {
+		Warning          Warning
+		ResponseMetadata api.ResponseMetadata
+	}
+
+	warnChatUserBody struct {
+		Data warnChatUserData `json:"data"`
+	}
+
+	warnChatUserData struct {
+		UserID string `json:"user_id"`
+		Reason string `json:"reason"`
+	}
+)
+
+// WarnChatUser warns a user in the specified broadcaster’s chat room, preventing them
+// from chat interaction until the warning is acknowledged.
+//
+// Reference: https://dev.twitch.tv/docs/api/reference/#warn-chat-user
+//
+// Requires a user access token that includes the moderator:manage:warnings scope.
+func (r ModerationResource) WarnChatUser(ctx context.Context, input WarnChatUserInput) (WarnChatUserOutput, error) {
+	const resource = "moderation/warnings"
+
+	moderatorID, authParams := ModeratorAuthParams(
+		input.BroadcasterID,
+		input.ModeratorID,
+		"moderator:manage:warnings",
+	)
+
+	values := url.Values{}
+	values.Set("broadcaster_id", input.BroadcasterID)
+	values.Set("moderator_id", moderatorID)
+
+	req, err := httpcore.NewAPIRequest(ctx, httpcore.RequestOptions{
+		APIType:   api.TypeHelix,
+		Resource:  resource,
+		Method:    http.MethodPost,
+		URLValues: values,
+		Body: warnChatUserBody{
+			Data: warnChatUserData{
+				UserID: input.UserID,
+				Reason: input.Reason,
+			},
+		},
+	}, true)
+	if err != nil {
+		return WarnChatUserOutput{}, err
+	}
+
+	var (
+		wrapper WarnChatUserWrapper
+		output  WarnChatUserOutput
+	)
+
+	metadata, err := r.client.doRequest(req, &wrapper, authParams)
+	output.ResponseMetadata = metadata
+
+	if err != nil {
+		return output, err
+	}
+
+	if len(wrapper.Data) != 0 {
+		output.Warning = wrapper.Data[0]
+	}
+
+	return output, nil
+}
@@ -0,0 +1,101 @@
+package helix
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/kvizyx/twitchkit/api/oauth"
+)
+
+func TestModerationAlternativeScopes(t *testing.T) {
+	ok := fakeResponse{status: http.StatusOK, body: `{"data":[]}`}
+
+	tests := []struct {
+		name    string
+		scopes  []string
+		call    func(client *Client) error
+		wantErr bool
+	}{
+		{
+			name:   "get moderators with moderation:read",
+			scopes: []string{"moderation:read"},
+			call: func(client *Client) error {
+				_, err := client.Moderation().GetModerators(context.Background(), GetModeratorsInput{BroadcasterID: "1"})
+				return err
+			},
+		},
+		{
+			name:   "get moderators with channel:manage:moderators",
+			scopes: []string{"channel:manage:moderators"},
+			call: func(client *Client) error {
+				_, err := client.Moderation().GetModerators(context.Background(), GetModeratorsInput{BroadcasterID: "1"})
+				return err
+			},
+		},
+		{
+			name:   "get banned users with moderator:manage:banned_users",
+			scopes: []string{"moderator:manage:banned_users"},
+			call: func(client *Client) error {
+				_, err := client.Moderation().GetBannedUsers(context.Background(), GetBannedUsersInput{BroadcasterID: "1"})
+				return err
+			},
+		},
+		{
+			name:   "get banned users without scopes",
+			scopes: []string{},
+			call: func(client *Client) error {
+				_, err := client.Moderation().GetBannedUsers(context.Background(), GetBannedUsersInput{BroadcasterID: "1"})
+				return err
+			},
+			wantErr: true,
+		},
+		{
+			name:   "check automod status with channel:manage:moderators",
+			scopes: []string{"channel:manage:moderators", "moderator:manage:banned_users"},
+			call: func(client *Client) error {
+				_, err := client.Moderation().CheckAutoModStatus(context.Background(), CheckAutoModStatusInput{
+					BroadcasterID: "1",
+				})
+				return err
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			httpClient := &fakeHTTPClient{responses: []fakeResponse{ok}}
+			client := newTestClient(t, httpClient, &fakeAuthProvider{scopes: tt.scopes})
+
+			err := tt.call(client)
+			if tt.wantErr {
+				if !errors.Is(err, oauth.ErrMissingScope) {
+					t.Errorf("err = %v, want %v", err, oauth.ErrMissingScope)
+				}
+
+				if sent := httpClient.requests(); len(sent) != 0 {
+					t.Errorf("sent %d requests, want 0", len(sent))
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("call: %v", err)
+			}
+		})
+	}
+}
+
+func TestAnyScopesMissingError(t *testing.T) {
+	client := newTestClient(t, &fakeHTTPClient{}, &fakeAuthProvider{scopes: []string{}})
+
+	_, err := client.Moderation().GetModerators(context.Background(), GetModeratorsInput{BroadcasterID: "1"})
+	if !errors.Is(err, oauth.ErrMissingScope) ||
+		!strings.Contains(err.Error(), "moderation:read or channel:manage:moderators") {
+		t.Errorf("err = %v, want missing scope of both alternatives", err)
+	}
+}
@@ -0,0 +1,285 @@
+package helix
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/kvizyx/twitchkit/api"
+	"github.com/kvizyx/twitchkit/http-core"
+)
+
+const maxPollsPerRequest = 20
+
+type PollsResource struct {
+	client Client
+}
+
+func (c Client) Polls() PollsResource {
+	return PollsResource{client: c}
+}
+
+// PollStatus is a status of the poll.
+type PollStatus string
+
+const (
+	PollStatusActive     PollStatus = "ACTIVE"
+	PollStatusCompleted  PollStatus = "COMPLETED"
+	PollStatusTerminated PollStatus = "TERMINATED"
+	PollStatusArchived   PollStatus = "ARCHIVED"
+	PollStatusModerated  PollStatus = "MODERATED"
+	PollStatusInvalid    PollStatus = "INVALID"
+)
+
+// IsTerminal returns whether the poll with this status is over.
+func (ps PollStatus) IsTerminal() bool {
+	return ps != PollStatusActive
+}
+
+type (
+	Poll struct {
+		ID                         string       `json:"id"`
+		BroadcasterID              string       `json:"broadcaster_id"`
+		BroadcasterName            string       `json:"broadcaster_name"`
+		BroadcasterLogin           string       `json:"broadcaster_login"`
+		Title                      string       `json:"title"`
+		Choices                    []PollChoice `json:"choices"`
+		BitsVotingEnabled          bool         `json:"bits_voting_enabled"`
+		BitsPerVote                int          `json:"bits_per_vote"`
+		ChannelPointsVotingEnabled bool         `json:"channel_points_voting_enabled"`
+		ChannelPointsPerVote       int          `json:"channel_points_per_vote"`
+		Status                     PollStatus   `json:"status"`
+		Duration                   int          `json:"duration"`
+		StartedAt                  time.Time    `json:"started_at"`
+		EndedAt                    *time.Time   `json:"ended_at"`
+	}
+
+	PollChoice struct {
+		ID                 string `json:"id"`
+		Title              string `json:"title"`
+		Votes              int    `json:"votes"`
+		ChannelPointsVotes int    `json:"channel_points_votes"`
+		BitsVotes          int    `json:"bits_votes"`
+	}
+
+	PollWrapper struct {
+		Data []Poll `json:"data"`
+	}
+
+	PollOutput struct {
+		Poll             Poll
+		ResponseMetadata api.ResponseMetadata
+	}
+)
+
+type (
+	GetPollsInput struct {
+		BroadcasterID string
+		IDs           []string
+		First         int
+		After         string
+	}
+
+	GetPollsOutput struct {
+		Polls            []Poll     `json:"data"`
+		Pagination       Pagination `json:"pagination"`
+		ResponseMetadata api.ResponseMetadata
+	}
+)
+
+// GetPolls gets a list of polls that the broadcaster created. Polls are available for
+// 90 days after they’re created.
+//
+// Reference: https://dev.twitch.tv/docs/api/reference/#get-polls
+//
+// Requires a user access token that includes the channel:read:polls or
+// channel:manage:polls scope.
+func (r PollsResource) GetPolls(ctx context.Context, input GetPollsInput) (GetPollsOutput, error) {
+	const resource = "polls"
+
+	if len(input.IDs) > maxPollsPerRequest {
+		return GetPollsOutput{}, TooManyItemsError("IDs", maxPollsPerRequest)
+	}
+
+	values := url.Values{}
+	values.Set("broadcaster_id", input.BroadcasterID)
+	addQuery(values, "id", input.IDs)
+	setQueryInt(values, "first", input.First)
+	setQuery(values, "after", input.After)
+
+	req, err := httpcore.NewAPIRequest(ctx, httpcore.RequestOptions{
+		APIType:   api.TypeHelix,
+		Resource:  resource,
+		Method:    http.MethodGet,
+		URLValues: values,
+	}, false)
+	if err != nil {
+		return GetPollsOutput{}, err
+	}
+
+	var output GetPollsOutput
+
+	metadata, err := r.client.doRequest(req, &output, RequestAuthParams{
+		UserID: input.BroadcasterID,
+		Scopes: []string{"channel:read:polls"},
+	})
+	output.ResponseMetadata = metadata
+
+	if err != nil {
+		return output, err
+	}
+
+	return output, nil
+}
+
+type (
+	CreatePollInput struct {
+		BroadcasterID string             `json:"broadcaster_id"`
+		Title         string             `json:"title"`
+		Choices       []CreatePollChoice `json:"choices"`
+
+		// Duration of the poll in seconds (from 15 to 1800).
+		Duration                   int  `json:"duration"`
+		ChannelPointsVotingEnabled bool `json:"channel_points_voting_enabled,omitempty"`
+		ChannelPointsPerVote       int  `json:"channel_points_per_vote,omitempty"`
+	}
+
+	CreatePollChoice struct {
+		Title string `json:"title"`
+	}
+)
+
+// CreatePoll creates a poll that viewers in the broadcaster’s channel can vote on.
+//
+// Reference: https://dev.twitch.tv/docs/api/reference/#create-poll
+//
+// Requires a user access token that includes the channel:manage:polls scope.
+func (r PollsResource) CreatePoll(ctx context.Context, input CreatePollInput) (PollOutput, error) {
+	const resource = "polls"
+
+	req, err := httpcore.NewAPIRequest(ctx, httpcore.RequestOptions{
+		APIType:  api.TypeHelix,
+		Resource: resource,
+		Method:   http.MethodPost,
+		Body:     input,
+	}, true)
+	if err != nil {
+		return PollOutput{}, err
+	}
+
+	return r.doPollRequest(req, input.BroadcasterID)
+}
+
+type EndPollInput struct {
+	BroadcasterID string `json:"broadcaster_id"`
+	ID            string `json:"id"`
+
+	// Status is either PollStatusTerminated (poll is ended and results are publicly
+	// visible) or PollStatusArchived (poll is ended and results are hidden).
+	Status PollStatus `json:"status"`
+}
+
+// EndPoll ends an active poll.
+//
+// Reference: https://dev.twitch.tv/docs/api/reference/#end-poll
+//
+// Requires a user access token that includes the channel:manage:polls scope.
+func (r PollsResource) EndPoll(ctx context.Context, input EndPollInput) (PollOutput, error) {
+	const resource = "polls"
+
+	req, err := httpcore.NewAPIRequest(ctx, httpcore.RequestOptions{
+		APIType:  api.TypeHelix,
+		Resource: resource,
+		Method:   http.MethodPatch,
+		Body:     input,
+	}, true)
+	if err != nil {
+		return PollOutput{}, err
+	}
+
+	return r.doPollRequest(req, input.BroadcasterID)
+}
+
+func (r PollsResource) doPollRequest(req *http.Request, broadcasterID string) (PollOutput, error) {
+	var (
+		wrapper PollWrapper
+		output  PollOutput
+	)
+
+	metadata, err := r.client.doRequest(req, &wrapper, RequestAuthParams{
+		UserID: broadcasterID,
+		Scopes: []string{"channel:manage:polls"},
+	})
+	output.ResponseMetadata = metadata
+
+	if err != nil {
+		return output, err
+	}
+
+	if len(wrapper.Data) != 0 {
+		output.Poll = wrapper.Data[0]
+	}
+
+	return output, nil
+}
+
+// WaitPoll polls the poll with the given interval until it reaches terminal status (see
+// PollStatus.IsTerminal) or context is done. The last known state of the poll is
+// returned along with the context error. Interval must be positive.
+func (r PollsResource) WaitPoll(
+	ctx context.Context,
+	broadcasterID, pollID string,
+	interval time.Duration,
+) (Poll, error) {
+	var poll Poll
+
+	err := waitUntil(ctx, interval, func() (bool, error) {
+		output, err := r.GetPolls(ctx, GetPollsInput{
+			BroadcasterID: broadcasterID,
+			IDs:           []string{pollID},
+		})
+		if err != nil {
+			return false, fmt.Errorf("get polls: %w", err)
+		}
+
+		if len(output.Polls) == 0 {
+			return false, ErrNotFound
+		}
+
+		poll = output.Polls[0]
+
+		return poll.Status.IsTerminal(), nil
+	})
+
+	return poll, err
+}
+
+// waitUntil calls done with the given interval until it returns true, error, or context
+// is done. Interval must be positive.
+func waitUntil(ctx context.Context, interval time.Duration, done func() (bool, error)) error {
+	if interval <= 0 {
+		return InvalidIntervalError(interval)
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		ok, err := done()
+		if err != nil {
+			return err
+		}
+
+		if ok {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,284 @@
+package helix
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/kvizyx/twitchkit/api"
+	"github.com/kvizyx/twitchkit/http-core"
+)
+
+const maxPredictionsPerRequest = 25
+
+type PredictionsResource struct {
+	client Client
+}
+
+func (c Client) Predictions() PredictionsResource {
+	return PredictionsResource{client: c}
+}
+
+// PredictionStatus is a status of the prediction.
+type PredictionStatus string
+
+const (
+	PredictionStatusActive   PredictionStatus = "ACTIVE"
+	PredictionStatusCanceled PredictionStatus = "CANCELED"
+	PredictionStatusLocked   PredictionStatus = "LOCKED"
+	PredictionStatusResolved PredictionStatus = "RESOLVED"
+)
+
+// IsTerminal returns whether the prediction with this status is over. Locked prediction
+// is not over yet, as it still has to be resolved or canceled.
+func (ps PredictionStatus) IsTerminal() bool {
+	return ps == PredictionStatusCanceled || ps == PredictionStatusResolved
+}
+
+// OutcomeColor is a color of the prediction outcome.
+type OutcomeColor string
+
+const (
+	OutcomeColorBlue OutcomeColor = "BLUE"
+	OutcomeColorPink OutcomeColor = "PINK"
+)
+
+type (
+	Prediction struct {
+		ID               string              `json:"id"`
+		BroadcasterID    string              `json:"broadcaster_id"`
+		BroadcasterName  string              `json:"broadcaster_name"`
+		BroadcasterLogin string              `json:"broadcaster_login"`
+		Title            string              `json:"title"`
+		WinningOutcomeID string              `json:"winning_outcome_id"`
+		Outcomes         []PredictionOutcome `json:"outcomes"`
+		PredictionWindow int                 `json:"prediction_window"`
+		Status           PredictionStatus    `json:"status"`
+		CreatedAt        time.Time           `json:"created_at"`
+		EndedAt          *time.Time          `json:"ended_at"`
+		LockedAt         *time.Time          `json:"locked_at"`
+	}
+
+	PredictionOutcome struct {
+		ID            string       `json:"id"`
+		Title         string       `json:"title"`
+		Users         int          `json:"users"`
+		ChannelPoints int          `json:"channel_points"`
+		TopPredictors []Predictor  `json:"top_predictors"`
+		Color         OutcomeColor `json:"color"`
+	}
+
+	Predictor struct {
+		UserID            string `json:"user_id"`
+		UserName          string `json:"user_name"`
+		UserLogin         string `json:"user_login"`
+		ChannelPointsUsed int    `json:"channel_points_used"`
+		ChannelPointsWon  int    `json:"channel_points_won"`
+	}
+
+	PredictionWrapper struct {
+		Data []Prediction `json:"data"`
+	}
+
+	PredictionOutput struct {
+		Prediction       Prediction
+		ResponseMetadata api.ResponseMetadata
+	}
+)
+
+type (
+	GetPredictionsInput struct {
+		BroadcasterID string
+		IDs           []string
+		First         int
+		After         string
+	}
+
+	GetPredictionsOutput struct {
+		Predictions      []Prediction `json:"data"`
+		Pagination       Pagination   `json:"pagination"`
+		ResponseMetadata api.ResponseMetadata
+	}
+)
+
+// GetPredictions gets a list of Channel Points Predictions that the broadcaster created.
+//
+// Reference: https://dev.twitch.tv/docs/api/reference/#get-predictions
+//
+// Requires a user access token that includes the channel:read:predictions or
+// channel:manage:predictions scope.
+func (r PredictionsResource) GetPredictions(
+	ctx context.Context,
+	input GetPredictionsInput,
+) (GetPredictionsOutput, error) {
+	const resource = "predictions"
+
+	if len(input.IDs) > maxPredictionsPerRequest {
+		return GetPredictionsOutput{}, TooManyItemsError("IDs", maxPredictionsPerRequest)
+	}
+
+	values := url.Values{}
+	values.Set("broadcaster_id", input.BroadcasterID)
+	addQuery(values, "id", input.IDs)
+	setQueryInt(values, "first", input.First)
+	setQuery(values, "after", input.After)
+
+	req, err := httpcore.NewAPIRequest(ctx, httpcore.RequestOptions{
+		APIType:   api.TypeHelix,
+		Resource:  resource,
+		Method:    http.MethodGet,
+		URLValues: values,
+	}, false)
+	if err != nil {
+		return GetPredictionsOutput{}, err
+	}
+
+	var output GetPredictionsOutput
+
+	metadata, err := r.client.doRequest(req, &output, RequestAuthParams{
+		UserID: input.BroadcasterID,
+		Scopes: []string{"channel:read:predictions"},
+	})
+	output.ResponseMetadata = metadata
+
+	if err != nil {
+		return output, err
+	}
+
+	return output, nil
+}
+
+type (
+	CreatePredictionInput struct {
+		BroadcasterID string `json:"broadcaster_id"`
+		Title         string `json:"title"`
+
+		// Outcomes are possible outcomes that viewers may choose from (from 2 to 10).
+		Outcomes []CreatePredictionOutcome `json:"outcomes"`
+
+		// PredictionWindow is a length of time in seconds that the prediction will run
+		// for (from 30 to 1800).
+		PredictionWindow int `json:"prediction_window"`
+	}
+
+	CreatePredictionOutcome struct {
+		Title string `json:"title"`
+	}
+)
+
+// CreatePrediction creates a Channel Points Prediction.
+//
+// Reference: https://dev.twitch.tv/docs/api/reference/#create-prediction
+//
+// Requires a user access token that includes the channel:manage:predictions scope.
+func (r PredictionsResource) CreatePrediction(
+	ctx context.Context,
+	input CreatePredictionInput,
+) (PredictionOutput, error) {
+	const resource = "predictions"
+
+	req, err := httpcore.NewAPIRequest(ctx, httpcore.RequestOptions{
+		APIType:  api.TypeHelix,
+		Resource: resource,
+		Method:   http.MethodPost,
+		Body:     input,
+	}, true)
+	if err != nil {
+		return PredictionOutput{}, err
+	}
+
+	return r.doPredictionRequest(req, input.BroadcasterID)
+}
+
+type EndPredictionInput struct {
+	BroadcasterID string `json:"broadcaster_id"`
+	ID            string `json:"id"`
+
+	// Status is one of PredictionStatusResolved, PredictionStatusCanceled (points are
+	// refunded) or PredictionStatusLocked (viewers can't make predictions anymore).
+	Status PredictionStatus `json:"status"`
+
+	// WinningOutcomeID is required if Status is PredictionStatusResolved.
+	WinningOutcomeID string `json:"winning_outcome_id,omitempty"`
+}
+
+// EndPrediction locks, resolves, or cancels a Channel Points Prediction.
+//
+// Reference: https://dev.twitch.tv/docs/api/reference/#end-prediction
+//
+// Requires a user access token that includes the channel:manage:predictions scope.
+func (r PredictionsResource) EndPrediction(ctx context.Context, input EndPredictionInput) (PredictionOutput, error) {
+	const resource = "predictions"
+
+	if input.Status == PredictionStatusResolved && len(input.WinningOutcomeID) == 0 {
+		return PredictionOutput{}, MissingInputError("WinningOutcomeID")
+	}
+
+	req, err := httpcore.NewAPIRequest(ctx, httpcore.RequestOptions{
+		APIType:  api.TypeHelix,
+		Resource: resource,
+		Method:   http.MethodPatch,
+		Body:     input,
+	}, true)
+	if err != nil {
+		return PredictionOutput{}, err
+	}
+
+	return r.doPredictionRequest(req, input.BroadcasterID)
+}
+
+func (r PredictionsResource) doPredictionRequest(req *http.Request, broadcasterID string) (PredictionOutput, error) {
+	var (
+		wrapper PredictionWrapper
+		output  PredictionOutput
+	)
+
+	metadata, err := r.client.doRequest(req, &wrapper, RequestAuthParams{
+		UserID: broadcasterID,
+		Scopes: []string{"channel:manage:predictions"},
+	})
+	output.ResponseMetadata = metadata
+
+	if err != nil {
+		return output, err
+	}
+
+	if len(wrapper.Data) != 0 {
+		output.Prediction = wrapper.Data[0]
+	}
+
+	return output, nil
+}
+
+// WaitPrediction polls the prediction with the given interval until it reaches terminal
+// status (see PredictionStatus.IsTerminal) or context is done. The last known state of
+// the prediction is returned along with the context error. Interval must be positive.
+func (r PredictionsResource) WaitPrediction(
+	ctx context.Context,
+	broadcasterID, predictionID string,
+	interval time.Duration,
+) (Prediction, error) {
+	var prediction Prediction
+
+	err := waitUntil(ctx, interval, func() (bool, error) {
+		output, err := r.GetPredictions(ctx, GetPredictionsInput{
+			BroadcasterID: broadcasterID,
+			IDs:           []string{predictionID},
+		})
+		if err != nil {
+			return false, fmt.Errorf("get predictions: %w", err)
+		}
+
+		if len(output.Predictions) == 0 {
+			return false, ErrNotFound
+		}
+
+		prediction = output.Predictions[0]
+
+		return prediction.Status.IsTerminal(), nil
+	})
+
+	return prediction, err
+}
@@ -0,0 +1,137 @@
+package helix
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/kvizyx/twitchkit/api"
+	"github.com/kvizyx/twitchkit/http-core"
+)
+
+type RaidsResource struct {
+	client Client
+}
+
+func (c Client) Raids() RaidsResource {
+	return RaidsResource{client: c}
+}
+
+type (
+	Raid struct {
+		CreatedAt time.Time `json:"created_at"`
+		IsMature  bool      `json:"is_mature"`
+	}
+
+	StartRaidWrapper struct {
+		Data []Raid `json:"data"`
+	}
+
+	StartRaidInput struct {
+		FromBroadcasterID string
+		ToBroadcasterID   string
+	}
+
+	StartRaidOutput struct {
+		Raid             Raid
+		ResponseMetadata api.ResponseMetadata
+	}
+)
+
+// StartRaid raids another channel by sending the broadcaster’s viewers to the targeted
+// channel. The raid starts after 90 seconds countdown (unless the broadcaster starts it
+// manually).
+//
+// Reference: https://dev.twitch.tv/docs/api/reference/#start-a-raid
+//
+// Requires a user access token that includes the channel:manage:raids scope.
+func (r RaidsResource) StartRaid(ctx context.Context, input StartRaidInput) (StartRaidOutput, error) {
+	const resource = "raids"
+
+	values := url.Values{}
+	values.Set("from_broadcaster_id", input.FromBroadcasterID)
+	values.Set("to_broadcaster_id", input.ToBroadcasterID)
+
+	req, err := httpcore.NewAPIRequest(ctx, httpcore.RequestOptions{
+		APIType:   api.TypeHelix,
+		Resource:  resource,
+		Method:    http.MethodPost,
+		URLValues: values,
+	}, false)
+	if err != nil {
+		return StartRaidOutput{}, err
+	}
+
+	var (
+		wrapper StartRaidWrapper
+		output  StartRaidOutput
+	)
+
+	metadata, err := r.client.doRequest(req, &wrapper, RequestAuthParams{
+		UserID: input.FromBroadcasterID,
+		Scopes: []string{"channel:manage:raids"},
+	})
+	output.ResponseMetadata = metadata
+
+	if err != nil {
+		return output, err
+	}
+
+	if len(wrapper.Data) != 0 {
+		output.Raid = wrapper.Data[0]
+	}
+
+	return output, nil
+}
+
+// CancelRaid cancels a pending raid.
+//
+// Reference: https://dev.twitch.tv/docs/api/reference/#cancel-a-raid
+//
+// Requires a user access token that includes the channel:manage:raids scope.
+func (r RaidsResource) CancelRaid(ctx context.Context, broadcasterID string) (api.ResponseMetadata, error) {
+	const resource = "raids"
+
+	values := url.Values{}
+	values.Set("broadcaster_id", broadcasterID)
+
+	req, err := httpcore.NewAPIRequest(ctx, httpcore.RequestOptions{
+		APIType:   api.TypeHelix,
+		Resource:  resource,
+		Method:    http.MethodDelete,
+		URLValues: values,
+	}, false)
+	if err != nil {
+		return api.ResponseMetadata{}, err
+	}
+
+	return r.client.doRequest(req, nil, RequestAuthParams{
+		UserID: broadcasterID,
+		Scopes: []string{"channel:manage:raids"},
+	})
+}
+
+// StartRaidWithShoutout starts a raid and sends a Shoutout to the raided broadcaster
+// from the same channel. Shoutout is sent on behalf of the raiding broadcaster, so their
+// token must also include the moderator:manage:shoutouts scope.
+//
+// Raid is not canceled if the Shoutout fails, the error is returned along with the
+// raid output then.
+func (r RaidsResource) StartRaidWithShoutout(ctx context.Context, input StartRaidInput) (StartRaidOutput, error) {
+	output, err := r.StartRaid(ctx, input)
+	if err != nil {
+		return output, fmt.Errorf("start raid: %w", err)
+	}
+
+	_, err = r.client.Chat().SendShoutout(ctx, SendShoutoutInput{
+		FromBroadcasterID: input.FromBroadcasterID,
+		ToBroadcasterID:   input.ToBroadcasterID,
+	})
+	if err != nil {
+		return output, fmt.Errorf("send shoutout: %w", err)
+	}
+
+	return output, nil
+}
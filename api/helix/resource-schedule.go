@@ -0,0 +1,386 @@
+package helix
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/kvizyx/twitchkit/api"
+	"github.com/kvizyx/twitchkit/http-core"
+)
+
+const (
+	maxScheduleSegmentsPerRequest = 100
+	maxScheduleSegmentsPerPage    = 25
+)
+
+type ScheduleResource struct {
+	client Client
+}
+
+func (c Client) Schedule() ScheduleResource {
+	return ScheduleResource{client: c}
+}
+
+type (
+	ScheduleCategory struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	}
+
+	ScheduleSegment struct {
+		ID        string    `json:"id"`
+		StartTime time.Time `json:"start_time"`
+		EndTime   time.Time `json:"end_time"`
+		Title     string    `json:"title"`
+
+		// CanceledUntil is set if the broadcaster canceled this segment of a recurring
+		// broadcast.
+		CanceledUntil *time.Time `json:"canceled_until"`
+
+		// Category is nil if the broadcaster didn't specify a category.
+		Category    *ScheduleCategory `json:"category"`
+		IsRecurring bool              `json:"is_recurring"`
+	}
+
+	ScheduleVacation struct {
+		StartTime time.Time `json:"start_time"`
+		EndTime   time.Time `json:"end_time"`
+	}
+
+	Schedule struct {
+		Segments         []ScheduleSegment `json:"segments"`
+		BroadcasterID    string            `json:"broadcaster_id"`
+		BroadcasterName  string            `json:"broadcaster_name"`
+		BroadcasterLogin string            `json:"broadcaster_login"`
+
+		// Vacation is nil if the broadcaster is not on vacation.
+		Vacation *ScheduleVacation `json:"vacation"`
+	}
+
+	ScheduleWrapper struct {
+		Data Schedule `json:"data"`
+	}
+)
+
+type (
+	GetChannelStreamScheduleInput struct {
+		BroadcasterID string
+		IDs           []string
+		StartTime     time.Time
+		First         int
+		After         string
+	}
+
+	GetChannelStreamScheduleOutput struct {
+		Schedule         Schedule   `json:"data"`
+		Pagination       Pagination `json:"pagination"`
+		ResponseMetadata api.ResponseMetadata
+	}
+)
+
+// GetChannelStreamSchedule gets the broadcaster’s streaming schedule. Twitch responds
+// with 404 if the broadcaster has no schedule yet.
+//
+// Reference: https://dev.twitch.tv/docs/api/reference/#get-channel-stream-schedule
+//
+// Requires an app access token or user access token.
+func (r ScheduleResource) GetChannelStreamSchedule(
+	ctx context.Context,
+	input GetChannelStreamScheduleInput,
+) (GetChannelStreamScheduleOutput, error) {
+	const resource = "schedule"
+
+	if len(input.IDs) > maxScheduleSegmentsPerRequest {
+		return GetChannelStreamScheduleOutput{}, TooManyItemsError("IDs", maxScheduleSegmentsPerRequest)
+	}
+
+	if input.First > maxScheduleSegmentsPerPage {
+		input.First = maxScheduleSegmentsPerPage
+	}
+
+	values := url.Values{}
+	values.Set("broadcaster_id", input.BroadcasterID)
+	addQuery(values, "id", input.IDs)
+	setQueryTime(values, "start_time", input.StartTime)
+	setQueryInt(values, "first", input.First)
+	setQuery(values, "after", input.After)
+
+	req, err := httpcore.NewAPIRequest(ctx, httpcore.RequestOptions{
+		APIType:   api.TypeHelix,
+		Resource:  resource,
+		Method:    http.MethodGet,
+		URLValues: values,
+	}, false)
+	if err != nil {
+		return GetChannelStreamScheduleOutput{}, err
+	}
+
+	var output GetChannelStreamScheduleOutput
+
+	metadata, err := r.client.doRequest(req, &output, RequestAuthParams{})
+	output.ResponseMetadata = metadata
+
+	if err != nil {
+		return output, err
+	}
+
+	return output, nil
+}
+
+type GetChannelICalendarOutput struct {
+	Events           []CalendarEvent
+	ResponseMetadata api.ResponseMetadata
+}
+
+// GetChannelICalendar gets the broadcaster’s streaming schedule as an iCalendar and
+// parses it into events.
+//
+// Reference: https://dev.twitch.tv/docs/api/reference/#get-channel-icalendar
+//
+// Requires no authorization.
+func (r ScheduleResource) GetChannelICalendar(
+	ctx context.Context,
+	broadcasterID string,
+) (GetChannelICalendarOutput, error) {
+	const resource = "schedule/icalendar"
+
+	values := url.Values{}
+	values.Set("broadcaster_id", broadcasterID)
+
+	req, err := httpcore.NewAPIRequest(ctx, httpcore.RequestOptions{
+		APIType:   api.TypeHelix,
+		Resource:  resource,
+		Method:    http.MethodGet,
+		URLValues: values,
+	}, false)
+	if err != nil {
+		return GetChannelICalendarOutput{}, err
+	}
+
+	var (
+		body   []byte
+		output GetChannelICalendarOutput
+	)
+
+	metadata, err := r.client.doRequest(req, &body, RequestAuthParams{})
+	output.ResponseMetadata = metadata
+
+	if err != nil {
+		return output, err
+	}
+
+	output.Events, err = ParseICalendar(bytes.NewReader(body))
+	if err != nil {
+		return output, err
+	}
+
+	return output, nil
+}
+
+// UpdateChannelStreamScheduleInput describes schedule settings to update. Only the set
+// (non-nil and non-zero) fields are sent.
+type UpdateChannelStreamScheduleInput struct {
+	BroadcasterID     string
+	IsVacationEnabled *bool
+
+	// VacationStartTime, VacationEndTime and Timezone are required if vacation is
+	// enabled. Timezone is an IANA time zone name (e.g. America/New_York).
+	VacationStartTime time.Time
+	VacationEndTime   time.Time
+	Timezone          string
+}
+
+// UpdateChannelStreamSchedule updates the broadcaster’s schedule settings, such as
+// scheduling a vacation.
+//
+// Reference: https://dev.twitch.tv/docs/api/reference/#update-channel-stream-schedule
+//
+// Requires a user access token that includes the channel:manage:schedule scope.
+func (r ScheduleResource) UpdateChannelStreamSchedule(
+	ctx context.Context,
+	input UpdateChannelStreamScheduleInput,
+) (api.ResponseMetadata, error) {
+	const resource = "schedule/settings"
+
+	values := url.Values{}
+	values.Set("broadcaster_id", input.BroadcasterID)
+	setQueryBool(values, "is_vacation_enabled", input.IsVacationEnabled)
+	setQueryTime(values, "vacation_start_time", input.VacationStartTime)
+	setQueryTime(values, "vacation_end_time", input.VacationEndTime)
+	setQuery(values, "timezone", input.Timezone)
+
+	req, err := httpcore.NewAPIRequest(ctx, httpcore.RequestOptions{
+		APIType:   api.TypeHelix,
+		Resource:  resource,
+		Method:    http.MethodPatch,
+		URLValues: values,
+	}, false)
+	if err != nil {
+		return api.ResponseMetadata{}, err
+	}
+
+	return r.client.doRequest(req, nil, RequestAuthParams{
+		UserID: input.BroadcasterID,
+		Scopes: []string{"channel:manage:schedule"},
+	})
+}
+
+type (
+	CreateScheduleSegmentInput struct {
+		BroadcasterID string `json:"-"`
+
+		StartTime time.Time `json:"start_time"`
+
+		// Timezone is an IANA time zone name of the broadcaster (e.g. America/New_York).
+		Timezone string `json:"timezone"`
+
+		// Duration is a length of the broadcast in minutes (from 30 to 1380).
+		Duration    int    `json:"duration,string"`
+		IsRecurring bool   `json:"is_recurring"`
+		CategoryID  string `json:"category_id,omitempty"`
+		Title       string `json:"title,omitempty"`
+	}
+
+	ScheduleSegmentOutput struct {
+		Segment          ScheduleSegment
+		ResponseMetadata api.ResponseMetadata
+	}
+)
+
+// CreateScheduleSegment adds a single or recurring broadcast to the broadcaster’s
+// streaming schedule.
+//
+// Reference: https://dev.twitch.tv/docs/api/reference/#create-channel-stream-schedule-segment
+//
+// Requires a user access token that includes the channel:manage:schedule scope.
+func (r ScheduleResource) CreateScheduleSegment(
+	ctx context.Context,
+	input CreateScheduleSegmentInput,
+) (ScheduleSegmentOutput, error) {
+	const resource = "schedule/segment"
+
+	values := url.Values{}
+	values.Set("broadcaster_id", input.BroadcasterID)
+
+	req, err := httpcore.NewAPIRequest(ctx, httpcore.RequestOptions{
+		APIType:   api.TypeHelix,
+		Resource:  resource,
+		Method:    http.MethodPost,
+		URLValues: values,
+		Body:      input,
+	}, true)
+	if err != nil {
+		return ScheduleSegmentOutput{}, err
+	}
+
+	return r.doSegmentRequest(req, input.BroadcasterID)
+}
+
+// UpdateScheduleSegmentInput describes schedule segment fields to update. Only the set
+// (non-nil) fields are sent.
+type UpdateScheduleSegmentInput struct {
+	BroadcasterID string `json:"-"`
+	ID            string `json:"-"`
+
+	StartTime *time.Time `json:"start_time,omitempty"`
+
+	// Duration is a length of the broadcast in minutes (from 30 to 1380).
+	Duration   *int    `json:"duration,omitempty,string"`
+	CategoryID *string `json:"category_id,omitempty"`
+	Title      *string `json:"title,omitempty"`
+	IsCanceled *bool   `json:"is_canceled,omitempty"`
+	Timezone   *string `json:"timezone,omitempty"`
+}
+
+// UpdateScheduleSegment updates a scheduled broadcast segment. For recurring segments,
+// updating applies to all of its broadcasts.
+//
+// Reference: https://dev.twitch.tv/docs/api/reference/#update-channel-stream-schedule-segment
+//
+// Requires a user access token that includes the channel:manage:schedule scope.
+func (r ScheduleResource) UpdateScheduleSegment(
+	ctx context.Context,
+	input UpdateScheduleSegmentInput,
+) (ScheduleSegmentOutput, error) {
+	const resource = "schedule/segment"
+
+	values := url.Values{}
+	values.Set("broadcaster_id", input.BroadcasterID)
+	values.Set("id", input.ID)
+
+	req, err := httpcore.NewAPIRequest(ctx, httpcore.RequestOptions{
+		APIType:   api.TypeHelix,
+		Resource:  resource,
+		Method:    http.MethodPatch,
+		URLValues: values,
+		Body:      input,
+	}, true)
+	if err != nil {
+		return ScheduleSegmentOutput{}, err
+	}
+
+	return r.doSegmentRequest(req, input.BroadcasterID)
+}
+
+func (r ScheduleResource) doSegmentRequest(req *http.Request, broadcasterID string) (ScheduleSegmentOutput, error) {
+	var (
+		wrapper ScheduleWrapper
+		output  ScheduleSegmentOutput
+	)
+
+	metadata, err := r.client.doRequest(req, &wrapper, RequestAuthParams{
+		UserID: broadcasterID,
+		Scopes: []string{"channel:manage:schedule"},
+	})
+	output.ResponseMetadata = metadata
+
+	if err != nil {
+		return output, err
+	}
+
+	if len(wrapper.Data.Segments) != 0 {
+		output.Segment = wrapper.Data.Segments[0]
+	}
+
+	return output, nil
+}
+
+type DeleteScheduleSegmentInput struct {
+	BroadcasterID string
+	ID            string
+}
+
+// DeleteScheduleSegment removes a broadcast segment from the broadcaster’s streaming
+// schedule. For recurring segments, all of its broadcasts are removed.
+//
+// Reference: https://dev.twitch.tv/docs/api/reference/#delete-channel-stream-schedule-segment
+//
+// Requires a user access token that includes the channel:manage:schedule scope.
+func (r ScheduleResource) DeleteScheduleSegment(
+	ctx context.Context,
+	input DeleteScheduleSegmentInput,
+) (api.ResponseMetadata, error) {
+	const resource = "schedule/segment"
+
+	values := url.Values{}
+	values.Set("broadcaster_id", input.BroadcasterID)
+	values.Set("id", input.ID)
+
+	req, err := httpcore.NewAPIRequest(ctx, httpcore.RequestOptions{
+		APIType:   api.TypeHelix,
+		Resource:  resource,
+		Method:    http.MethodDelete,
+		URLValues: values,
+	}, false)
+	if err != nil {
+		return api.ResponseMetadata{}, err
+	}
+
+	return r.client.doRequest(req, nil, RequestAuthParams{
+		UserID: input.BroadcasterID,
+		Scopes: []string{"channel:manage:schedule"},
+	})
+}
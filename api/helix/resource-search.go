@@ -0,0 +1,153 @@
+package helix
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/kvizyx/twitchkit/api"
+	"github.com/kvizyx/twitchkit/http-core"
+)
+
+type SearchResource struct {
+	client Client
+}
+
+func (c Client) Search() SearchResource {
+	return SearchResource{client: c}
+}
+
+type (
+	SearchCategoriesInput struct {
+		Query string
+		First int
+		After string
+	}
+
+	SearchCategoriesOutput struct {
+		Categories       []Game     `json:"data"`
+		Pagination       Pagination `json:"pagination"`
+		ResponseMetadata api.ResponseMetadata
+	}
+)
+
+// SearchCategories gets the games or categories that match the specified query. The
+// match is made on the category name, fully or partially.
+//
+// Reference: https://dev.twitch.tv/docs/api/reference/#search-categories
+//
+// Requires an app access token or user access token.
+func (r SearchResource) SearchCategories(
+	ctx context.Context,
+	input SearchCategoriesInput,
+) (SearchCategoriesOutput, error) {
+	const resource = "search/categories"
+
+	if len(input.Query) == 0 {
+		return SearchCategoriesOutput{}, MissingInputError("Query")
+	}
+
+	values := url.Values{}
+	values.Set("query", input.Query)
+	setQueryInt(values, "first", input.First)
+	setQuery(values, "after", input.After)
+
+	req, err := httpcore.NewAPIRequest(ctx, httpcore.RequestOptions{
+		APIType:   api.TypeHelix,
+		Resource:  resource,
+		Method:    http.MethodGet,
+		URLValues: values,
+	}, false)
+	if err != nil {
+		return SearchCategoriesOutput{}, err
+	}
+
+	var output SearchCategoriesOutput
+
+	metadata, err := r.client.doRequest(req, &output, RequestAuthParams{})
+	output.ResponseMetadata = metadata
+
+	if err != nil {
+		return output, err
+	}
+
+	return output, nil
+}
+
+type (
+	SearchedChannel struct {
+		ID                  string   `json:"id"`
+		BroadcasterLogin    string   `json:"broadcaster_login"`
+		DisplayName         string   `json:"display_name"`
+		BroadcasterLanguage string   `json:"broadcaster_language"`
+		GameID              string   `json:"game_id"`
+		GameName            string   `json:"game_name"`
+		IsLive              bool     `json:"is_live"`
+		Tags                []string `json:"tags"`
+		ThumbnailURL        string   `json:"thumbnail_url"`
+		Title               string   `json:"title"`
+
+		// StartedAt is a RFC3339 time when the broadcaster started streaming, it's
+		// empty if the broadcaster is not live.
+		StartedAt string `json:"started_at"`
+	}
+
+	SearchChannelsInput struct {
+		Query string
+
+		// LiveOnly returns only channels that are streaming live now.
+		LiveOnly bool
+		First    int
+		After    string
+	}
+
+	SearchChannelsOutput struct {
+		Channels         []SearchedChannel `json:"data"`
+		Pagination       Pagination        `json:"pagination"`
+		ResponseMetadata api.ResponseMetadata
+	}
+)
+
+// SearchChannels gets the channels that match the specified query and have streamed
+// content within the past 6 months. The match is made on the broadcaster login name.
+//
+// Reference: https://dev.twitch.tv/docs/api/reference/#search-channels
+//
+// Requires an app access token or user access token.
+func (r SearchResource) SearchChannels(ctx context.Context, input SearchChannelsInput) (SearchChannelsOutput, error) {
+	const resource = "search/channels"
+
+	if len(input.Query) == 0 {
+		return SearchChannelsOutput{}, MissingInputError("Query")
+	}
+
+	values := url.Values{}
+	values.Set("query", input.Query)
+	setQueryInt(values, "first", input.First)
+	setQuery(values, "after", input.After)
+
+	if input.LiveOnly {
+		values.Set("live_only", "true")
+	}
+
+	req, err := httpcore.NewAPIRequest(ctx, httpcore.RequestOptions{
+		APIType:   api.TypeHelix,
+		Resource:  resource,
+		Method:    http.MethodGet,
+		URLValues: values,
+	}, false)
+	if err != nil {
+		return SearchChannelsOutput{}, err
+	}
+
+	var output SearchChannelsOutput
+
+	metadata, err := r.client.doRequest(req, &output, RequestAuthParams{})
+	output.ResponseMetadata = metadata
+
+	if err != nil {
+		return output, err
+	}
+
+	return output, nil
+}
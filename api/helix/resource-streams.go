@@ -0,0 +1,372 @@
+package helix
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/kvizyx/twitchkit/api"
+	"github.com/kvizyx/twitchkit/http-core"
+)
+
+const maxStreamFiltersPerRequest = 100
+
+type StreamsResource struct {
+	client Client
+}
+
+func (c Client) Streams() StreamsResource {
+	return StreamsResource{client: c}
+}
+
+// StreamType is a type of the stream.
+type StreamType string
+
+const (
+	StreamTypeAll  StreamType = "all"
+	StreamTypeLive StreamType = "live"
+)
+
+type (
+	Stream struct {
+		ID           string    `json:"id"`
+		UserID       string    `json:"user_id"`
+		UserLogin    string    `json:"user_login"`
+		UserName     string    `json:"user_name"`
+		GameID       string    `json:"game_id"`
+		GameName     string    `json:"game_name"`
+		Type         string    `json:"type"`
+		Title        string    `json:"title"`
+		Tags         []string  `json:"tags"`
+		ViewerCount  int       `json:"viewer_count"`
+		StartedAt    time.Time `json:"started_at"`
+		Language     string    `json:"language"`
+		ThumbnailURL string    `json:"thumbnail_url"`
+		IsMature     bool      `json:"is_mature"`
+	}
+
+	GetStreamsInput struct {
+		UserIDs    []string
+		UserLogins []string
+		GameIDs    []string
+		Type       StreamType
+		Languages  []string
+		First      int
+		Before     string
+		After      string
+	}
+
+	GetStreamsOutput struct {
+		Streams          []Stream   `json:"data"`
+		Pagination       Pagination `json:"pagination"`
+		ResponseMetadata api.ResponseMetadata
+	}
+)
+
+// GetStreams gets a list of all streams. The list is in descending order by the number
+// of viewers watching the stream.
+//
+// Reference: https://dev.twitch.tv/docs/api/reference/#get-streams
+//
+// Requires an app access token or user access token.
+func (r StreamsResource) GetStreams(ctx context.Context, input GetStreamsInput) (GetStreamsOutput, error) {
+	const resource = "streams"
+
+	filters := map[string][]string{
+		"UserIDs":    input.UserIDs,
+		"UserLogins": input.UserLogins,
+		"GameIDs":    input.GameIDs,
+		"Languages":  input.Languages,
+	}
+
+	for field, items := range filters {
+		if len(items) > maxStreamFiltersPerRequest {
+			return GetStreamsOutput{}, TooManyItemsError(field, maxStreamFiltersPerRequest)
+		}
+	}
+
+	values := url.Values{}
+	addQuery(values, "user_id", input.UserIDs)
+	addQuery(values, "user_login", input.UserLogins)
+	addQuery(values, "game_id", input.GameIDs)
+	setQuery(values, "type", string(input.Type))
+	addQuery(values, "language", input.Languages)
+	setQueryInt(values, "first", input.First)
+	setQuery(values, "before", input.Before)
+	setQuery(values, "after", input.After)
+
+	req, err := httpcore.NewAPIRequest(ctx, httpcore.RequestOptions{
+		APIType:   api.TypeHelix,
+		Resource:  resource,
+		Method:    http.MethodGet,
+		URLValues: values,
+	}, false)
+	if err != nil {
+		return GetStreamsOutput{}, err
+	}
+
+	var output GetStreamsOutput
+
+	metadata, err := r.client.doRequest(req, &output, RequestAuthParams{})
+	output.ResponseMetadata = metadata
+
+	if err != nil {
+		return output, err
+	}
+
+	return output, nil
+}
+
+type GetFollowedStreamsInput struct {
+	UserID string
+	First  int
+	After  string
+}
+
+// GetFollowedStreams gets the list of broadcasters that the user follows and that are
+// streaming live.
+//
+// Reference: https://dev.twitch.tv/docs/api/reference/#get-followed-streams
+//
+// Requires a user access token that includes the user:read:follows scope.
+func (r StreamsResource) GetFollowedStreams(
+	ctx context.Context,
+	input GetFollowedStreamsInput,
+) (GetStreamsOutput, error) {
+	const resource = "streams/followed"
+
+	values := url.Values{}
+	values.Set("user_id", input.UserID)
+	setQueryInt(values, "first", input.First)
+	setQuery(values, "after", input.After)
+
+	req, err := httpcore.NewAPIRequest(ctx, httpcore.RequestOptions{
+		APIType:   api.TypeHelix,
+		Resource:  resource,
+		Method:    http.MethodGet,
+		URLValues: values,
+	}, false)
+	if err != nil {
+		return GetStreamsOutput{}, err
+	}
+
+	var output GetStreamsOutput
+
+	metadata, err := r.client.doRequest(req, &output, RequestAuthParams{
+		UserID: input.UserID,
+		Scopes: []string{"user:read:follows"},
+	})
+	output.ResponseMetadata = metadata
+
+	if err != nil {
+		return output, err
+	}
+
+	return output, nil
+}
+
+type (
+	GetStreamKeyWrapper struct {
+		Data []GetStreamKeyOutput `json:"data"`
+	}
+
+	GetStreamKeyOutput struct {
+		StreamKey        string `json:"stream_key"`
+		ResponseMetadata api.ResponseMetadata
+	}
+)
+
+// GetStreamKey gets the channel’s stream key.
+//
+// Reference: https://dev.twitch.tv/docs/api/reference/#get-stream-key
+//
+// Requires a user access token that includes the channel:read:stream_key scope.
+func (r StreamsResource) GetStreamKey(ctx context.Context, broadcasterID string) (GetStreamKeyOutput, error) {
+	const resource = "streams/key"
+
+	values := url.Values{}
+	values.Set("broadcaster_id", broadcasterID)
+
+	req, err := httpcore.NewAPIRequest(ctx, httpcore.RequestOptions{
+		APIType:   api.TypeHelix,
+		Resource:  resource,
+		Method:    http.MethodGet,
+		URLValues: values,
+	}, false)
+	if err != nil {
+		return GetStreamKeyOutput{}, err
+	}
+
+	var (
+		wrapper GetStreamKeyWrapper
+		output  GetStreamKeyOutput
+	)
+
+	metadata, err := r.client.doRequest(req, &wrapper, RequestAuthParams{
+		UserID: broadcasterID,
+		Scopes: []string{"channel:read:stream_key"},
+	})
+	output.ResponseMetadata = metadata
+
+	if err != nil {
+		return output, err
+	}
+
+	if len(wrapper.Data) != 0 {
+		output.StreamKey = wrapper.Data[0].StreamKey
+	}
+
+	return output, nil
+}
+
+type (
+	StreamMarker struct {
+		ID              string    `json:"id"`
+		CreatedAt       time.Time `json:"created_at"`
+		Description     string    `json:"description"`
+		PositionSeconds int       `json:"position_seconds"`
+		URL             string    `json:"url"`
+	}
+
+	CreateStreamMarkerWrapper struct {
+		Data []StreamMarker `json:"data"`
+	}
+
+	CreateStreamMarkerInput struct {
+		UserID      string `json:"user_id"`
+		Description string `json:"description,omitempty"`
+	}
+
+	CreateStreamMarkerOutput struct {
+		Marker           StreamMarker
+		ResponseMetadata api.ResponseMetadata
+	}
+)
+
+// CreateStreamMarker adds a marker to a live stream. A marker is an arbitrary point in a
+// live stream that the broadcaster or editor wants to mark, so they can return to that
+// spot later to create video highlights.
+//
+// Reference: https://dev.twitch.tv/docs/api/reference/#create-stream-marker
+//
+// Requires a user access token that includes the channel:manage:broadcast scope.
+func (r StreamsResource) CreateStreamMarker(
+	ctx context.Context,
+	input CreateStreamMarkerInput,
+) (CreateStreamMarkerOutput, error) {
+	const resource = "streams/markers"
+
+	req, err := httpcore.NewAPIRequest(ctx, httpcore.RequestOptions{
+		APIType:  api.TypeHelix,
+		Resource: resource,
+		Method:   http.MethodPost,
+		Body:     input,
+	}, true)
+	if err != nil {
+		return CreateStreamMarkerOutput{}, err
+	}
+
+	var (
+		wrapper CreateStreamMarkerWrapper
+		output  CreateStreamMarkerOutput
+	)
+
+	metadata, err := r.client.doRequest(req, &wrapper, RequestAuthParams{
+		UserID: input.UserID,
+		Scopes: []string{"channel:manage:broadcast"},
+	})
+	output.ResponseMetadata = metadata
+
+	if err != nil {
+		return output, err
+	}
+
+	if len(wrapper.Data) != 0 {
+		output.Marker = wrapper.Data[0]
+	}
+
+	return output, nil
+}
+
+type (
+	UserStreamMarkers struct {
+		UserID    string              `json:"user_id"`
+		UserName  string              `json:"user_name"`
+		UserLogin string              `json:"user_login"`
+		Videos    []VideoStreamMarker `json:"videos"`
+	}
+
+	VideoStreamMarker struct {
+		VideoID string         `json:"video_id"`
+		Markers []StreamMarker `json:"markers"`
+	}
+
+	GetStreamMarkersInput struct {
+		// TokenUserID is an ID of the user whose user access token is used for the
+		// request. It's UserID if empty.
+		TokenUserID string
+
+		// UserID and VideoID are mutually exclusive.
+		UserID  string
+		VideoID string
+		First   int
+		Before  string
+		After   string
+	}
+
+	GetStreamMarkersOutput struct {
+		StreamMarkers    []UserStreamMarkers `json:"data"`
+		Pagination       Pagination          `json:"pagination"`
+		ResponseMetadata api.ResponseMetadata
+	}
+)
+
+// GetStreamMarkers gets a list of markers from the user’s most recent stream or from
+// the specified VOD/video.
+//
+// Reference: https://dev.twitch.tv/docs/api/reference/#get-stream-markers
+//
+// Requires a user access token that includes the user:read:broadcast or channel:manage:broadcast scope.
+func (r StreamsResource) GetStreamMarkers(
+	ctx context.Context,
+	input GetStreamMarkersInput,
+) (GetStreamMarkersOutput, error) {
+	const resource = "streams/markers"
+
+	values := url.Values{}
+	setQuery(values, "user_id", input.UserID)
+	setQuery(values, "video_id", input.VideoID)
+	setQueryInt(values, "first", input.First)
+	setQuery(values, "before", input.Before)
+	setQuery(values, "after", input.After)
+
+	req, err := httpcore.NewAPIRequest(ctx, httpcore.RequestOptions{
+		APIType:   api.TypeHelix,
+		Resource:  resource,
+		Method:    http.MethodGet,
+		URLValues: values,
+	}, false)
+	if err != nil {
+		return GetStreamMarkersOutput{}, err
+	}
+
+	tokenUserID := input.TokenUserID
+	if len(tokenUserID) == 0 {
+		tokenUserID = input.UserID
+	}
+
+	var output GetStreamMarkersOutput
+
+	metadata, err := r.client.doRequest(req, &output, RequestAuthParams{
+		UserID:    tokenUserID,
+		AnyScopes: []string{"user:read:broadcast", "channel:manage:broadcast"},
+	})
+	output.ResponseMetadata = metadata
+
+	if err != nil {
+		return output, err
+	}
+
+	return output, nil
+}
@@ -0,0 +1,27 @@
+package helix
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/kvizyx/twitchkit/api/oauth"
+)
+
+func TestBroadcastScopeAlternatives(t *testing.T) {
+	provider := &fakeAuthProvider{scopes: []string{"channel:manage:broadcast"}}
+	httpClient := &fakeHTTPClient{responses: []fakeResponse{{status: http.StatusOK, body: `{"data":[]}`}}}
+	client := newTestClient(t, httpClient, provider)
+
+	_, err := client.Streams().GetStreamMarkers(context.Background(), GetStreamMarkersInput{UserID: "1"})
+	if err != nil {
+		t.Fatalf("get stream markers with channel:manage:broadcast: %v", err)
+	}
+
+	// channel:manage:broadcast is an alternative only for stream markers.
+	_, err = client.Users().GetUserExtensions(context.Background(), "1")
+	if !errors.Is(err, oauth.ErrMissingScope) {
+		t.Errorf("get user extensions: err = %v, want %v", err, oauth.ErrMissingScope)
+	}
+}
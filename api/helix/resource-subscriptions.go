@@ -0,0 +1,191 @@
+package helix
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/kvizyx/twitchkit/api"
+	"github.com/kvizyx/twitchkit/http-core"
+)
+
+const maxSubscriptionUsersPerRequest = 100
+
+type SubscriptionsResource struct {
+	client Client
+}
+
+func (c Client) Subscriptions() SubscriptionsResource {
+	return SubscriptionsResource{client: c}
+}
+
+type (
+	Subscription struct {
+		BroadcasterID    string `json:"broadcaster_id"`
+		BroadcasterLogin string `json:"broadcaster_login"`
+		BroadcasterName  string `json:"broadcaster_name"`
+		GifterID         string `json:"gifter_id"`
+		GifterLogin      string `json:"gifter_login"`
+		GifterName       string `json:"gifter_name"`
+		IsGift           bool   `json:"is_gift"`
+		PlanName         string `json:"plan_name"`
+
+		// Tier is "1000", "2000" or "3000".
+		Tier      string `json:"tier"`
+		UserID    string `json:"user_id"`
+		UserName  string `json:"user_name"`
+		UserLogin string `json:"user_login"`
+	}
+
+	GetBroadcasterSubscriptionsInput struct {
+		BroadcasterID string
+		UserIDs       []string
+		First         int
+		After         string
+		Before        string
+	}
+
+	GetBroadcasterSubscriptionsOutput struct {
+		Subscriptions []Subscription `json:"data"`
+		Pagination    Pagination     `json:"pagination"`
+
+		// Total is the total number of users that subscribe to this broadcaster.
+		Total int `json:"total"`
+
+		// Points is the current number of subscriber points earned by this broadcaster.
+		Points           int `json:"points"`
+		ResponseMetadata api.ResponseMetadata
+	}
+)
+
+// GetBroadcasterSubscriptions gets a list of users that subscribe to the specified
+// broadcaster.
+//
+// Reference: https://dev.twitch.tv/docs/api/reference/#get-broadcaster-subscriptions
+//
+// Requires a user access token that includes the channel:read:subscriptions scope.
+func (r SubscriptionsResource) GetBroadcasterSubscriptions(
+	ctx context.Context,
+	input GetBroadcasterSubscriptionsInput,
+) (GetBroadcasterSubscriptionsOutput, error) {
+	const resource = "subscriptions"
+
+	if len(input.UserIDs) > maxSubscriptionUsersPerRequest {
+		return GetBroadcasterSubscriptionsOutput{}, TooManyItemsError("UserIDs", maxSubscriptionUsersPerRequest)
+	}
+
+	values := url.Values{}
+	values.Set("broadcaster_id", input.BroadcasterID)
+	addQuery(values, "user_id", input.UserIDs)
+	setQueryInt(values, "first", input.First)
+	setQuery(values, "after", input.After)
+	setQuery(values, "before", input.Before)
+
+	req, err := httpcore.NewAPIRequest(ctx, httpcore.RequestOptions{
+		APIType:   api.TypeHelix,
+		Resource:  resource,
+		Method:    http.MethodGet,
+		URLValues: values,
+	}, false)
+	if err != nil {
+		return GetBroadcasterSubscriptionsOutput{}, err
+	}
+
+	var output GetBroadcasterSubscriptionsOutput
+
+	metadata, err := r.client.doRequest(req, &output, RequestAuthParams{
+		UserID: input.BroadcasterID,
+		Scopes: []string{"channel:read:subscriptions"},
+	})
+	output.ResponseMetadata = metadata
+
+	if err != nil {
+		return output, err
+	}
+
+	return output, nil
+}
+
+type (
+	UserSubscription struct {
+		BroadcasterID    string `json:"broadcaster_id"`
+		BroadcasterLogin string `json:"broadcaster_login"`
+		BroadcasterName  string `json:"broadcaster_name"`
+		GifterID         string `json:"gifter_id"`
+		GifterLogin      string `json:"gifter_login"`
+		GifterName       string `json:"gifter_name"`
+		IsGift           bool   `json:"is_gift"`
+		Tier             string `json:"tier"`
+	}
+
+	CheckUserSubscriptionWrapper struct {
+		Data []UserSubscription `json:"data"`
+	}
+
+	CheckUserSubscriptionInput struct {
+		BroadcasterID string
+
+		// UserID is an ID of the user whose subscription is checked. User access token
+		// of this user is used for the request.
+		UserID string
+	}
+
+	CheckUserSubscriptionOutput struct {
+		IsSubscribed     bool
+		Subscription     UserSubscription
+		ResponseMetadata api.ResponseMetadata
+	}
+)
+
+// CheckUserSubscription checks whether the user subscribes to the broadcaster’s channel.
+// IsSubscribed is false (and no error is returned) if the user doesn't subscribe.
+//
+// Reference: https://dev.twitch.tv/docs/api/reference/#check-user-subscription
+//
+// Requires a user access token that includes the user:read:subscriptions scope.
+func (r SubscriptionsResource) CheckUserSubscription(
+	ctx context.Context,
+	input CheckUserSubscriptionInput,
+) (CheckUserSubscriptionOutput, error) {
+	const resource = "subscriptions/user"
+
+	values := url.Values{}
+	values.Set("broadcaster_id", input.BroadcasterID)
+	values.Set("user_id", input.UserID)
+
+	req, err := httpcore.NewAPIRequest(ctx, httpcore.RequestOptions{
+		APIType:   api.TypeHelix,
+		Resource:  resource,
+		Method:    http.MethodGet,
+		URLValues: values,
+	}, false)
+	if err != nil {
+		return CheckUserSubscriptionOutput{}, err
+	}
+
+	var (
+		wrapper CheckUserSubscriptionWrapper
+		output  CheckUserSubscriptionOutput
+	)
+
+	metadata, err := r.client.doRequest(req, &wrapper, RequestAuthParams{
+		UserID: input.UserID,
+		Scopes: []string{"user:read:subscriptions"},
+	})
+	output.ResponseMetadata = metadata
+
+	if metadata.StatusCode == http.StatusNotFound {
+		return output, nil
+	}
+
+	if err != nil {
+		return output, err
+	}
+
+	if len(wrapper.Data) != 0 {
+		output.IsSubscribed = true
+		output.Subscription = wrapper.Data[0]
+	}
+
+	return output, nil
+}
@@ -0,0 +1,156 @@
+package helix
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/kvizyx/twitchkit/api"
+	"github.com/kvizyx/twitchkit/http-core"
+)
+
+type TeamsResource struct {
+	client Client
+}
+
+func (c Client) Teams() TeamsResource {
+	return TeamsResource{client: c}
+}
+
+type (
+	// TeamInfo is information about the team common for ChannelTeam and Team.
+	TeamInfo struct {
+		ID                 string `json:"id"`
+		TeamName           string `json:"team_name"`
+		TeamDisplayName    string `json:"team_display_name"`
+		Info               string `json:"info"`
+		ThumbnailURL       string `json:"thumbnail_url"`
+		BackgroundImageURL string `json:"background_image_url"`
+		Banner             string `json:"banner"`
+
+		// CreatedAt and UpdatedAt are UTC times in "2006-01-02 15:04:05" format, not
+		// in RFC3339.
+		CreatedAt string `json:"created_at"`
+		UpdatedAt string `json:"updated_at"`
+	}
+
+	ChannelTeam struct {
+		TeamInfo
+
+		BroadcasterID    string `json:"broadcaster_id"`
+		BroadcasterLogin string `json:"broadcaster_login"`
+		BroadcasterName  string `json:"broadcaster_name"`
+	}
+
+	GetChannelTeamsOutput struct {
+		Teams            []ChannelTeam `json:"data"`
+		ResponseMetadata api.ResponseMetadata
+	}
+)
+
+// GetChannelTeams gets the list of Twitch teams that the broadcaster is a member of.
+//
+// Reference: https://dev.twitch.tv/docs/api/reference/#get-channel-teams
+//
+// Requires an app access token or user access token.
+func (r TeamsResource) GetChannelTeams(ctx context.Context, broadcasterID string) (GetChannelTeamsOutput, error) {
+	const resource = "teams/channel"
+
+	values := url.Values{}
+	values.Set("broadcaster_id", broadcasterID)
+
+	req, err := httpcore.NewAPIRequest(ctx, httpcore.RequestOptions{
+		APIType:   api.TypeHelix,
+		Resource:  resource,
+		Method:    http.MethodGet,
+		URLValues: values,
+	}, false)
+	if err != nil {
+		return GetChannelTeamsOutput{}, err
+	}
+
+	var output GetChannelTeamsOutput
+
+	metadata, err := r.client.doRequest(req, &output, RequestAuthParams{})
+	output.ResponseMetadata = metadata
+
+	if err != nil {
+		return output, err
+	}
+
+	return output, nil
+}
+
+type (
+	TeamMember struct {
+		UserID    string `json:"user_id"`
+		UserLogin string `json:"user_login"`
+		UserName  string `json:"user_name"`
+	}
+
+	Team struct {
+		TeamInfo
+
+		Users []TeamMember `json:"users"`
+	}
+
+	TeamWrapper struct {
+		Data []Team `json:"data"`
+	}
+
+	GetTeamsInput struct {
+		// Name and ID are mutually exclusive.
+		Name string
+		ID   string
+	}
+
+	GetTeamsOutput struct {
+		Team             Team
+		ResponseMetadata api.ResponseMetadata
+	}
+)
+
+// GetTeams gets information about the specified Twitch team.
+//
+// Reference: https://dev.twitch.tv/docs/api/reference/#get-teams
+//
+// Requires an app access token or user access token.
+func (r TeamsResource) GetTeams(ctx context.Context, input GetTeamsInput) (GetTeamsOutput, error) {
+	const resource = "teams"
+
+	if len(input.Name) == 0 && len(input.ID) == 0 {
+		return GetTeamsOutput{}, MissingInputError("Name or ID")
+	}
+
+	values := url.Values{}
+	setQuery(values, "name", input.Name)
+	setQuery(values, "id", input.ID)
+
+	req, err := httpcore.NewAPIRequest(ctx, httpcore.RequestOptions{
+		APIType:   api.TypeHelix,
+		Resource:  resource,
+		Method:    http.MethodGet,
+		URLValues: values,
+	}, false)
+	if err != nil {
+		return GetTeamsOutput{}, err
+	}
+
+	var (
+		wrapper TeamWrapper
+		output  GetTeamsOutput
+	)
+
+	metadata, err := r.client.doRequest(req, &wrapper, RequestAuthParams{})
+	output.ResponseMetadata = metadata
+
+	if err != nil {
+		return output, err
+	}
+
+	if len(wrapper.Data) != 0 {
+		output.Team = wrapper.Data[0]
+	}
+
+	return output, nil
+}
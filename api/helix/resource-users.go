@@ -0,0 +1,479 @@
+package helix
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/kvizyx/twitchkit/api"
+	"github.com/kvizyx/twitchkit/http-core"
+)
+
+const maxUsersPerRequest = 100
+
+type UsersResource struct {
+	client Client
+}
+
+func (c Client) Users() UsersResource {
+	return UsersResource{client: c}
+}
+
+type (
+	User struct {
+		ID              string    `json:"id"`
+		Login           string    `json:"login"`
+		DisplayName     string    `json:"display_name"`
+		Type            string    `json:"type"`
+		BroadcasterType string    `json:"broadcaster_type"`
+		Description     string    `json:"description"`
+		ProfileImageURL string    `json:"profile_image_url"`
+		OfflineImageURL string    `json:"offline_image_url"`
+		Email           string    `json:"email"`
+		CreatedAt       time.Time `json:"created_at"`
+	}
+
+	GetUsersInput struct {
+		IDs    []string
+		Logins []string
+
+		// EmailUserID is an ID of the user whose email should be included in the response.
+		// Request is made with user access token of this user, so it must include the
+		// user:read:email scope. If IDs and Logins are empty, this user is returned.
+		EmailUserID string
+	}
+
+	GetUsersOutput struct {
+		Users            []User `json:"data"`
+		ResponseMetadata api.ResponseMetadata
+	}
+)
+
+// GetUsers gets information about one or more users. You may look up users using their
+// user ID, login name, or both but the sum total of the number of users you may look up
+// is 100.
+//
+// Reference: https://dev.twitch.tv/docs/api/reference/#get-users
+//
+// Requires an app access token or user access token. User access token with the
+// user:read:email scope is required to include the user's email.
+func (r UsersResource) GetUsers(ctx context.Context, input GetUsersInput) (GetUsersOutput, error) {
+	const resource = "users"
+
+	if len(input.IDs)+len(input.Logins) > maxUsersPerRequest {
+		return GetUsersOutput{}, TooManyItemsError("IDs and Logins", maxUsersPerRequest)
+	}
+
+	values := url.Values{}
+	addQuery(values, "id", input.IDs)
+	addQuery(values, "login", input.Logins)
+
+	req, err := httpcore.NewAPIRequest(ctx, httpcore.RequestOptions{
+		APIType:   api.TypeHelix,
+		Resource:  resource,
+		Method:    http.MethodGet,
+		URLValues: values,
+	}, false)
+	if err != nil {
+		return GetUsersOutput{}, err
+	}
+
+	var authParams RequestAuthParams
+	if len(input.EmailUserID) != 0 {
+		authParams = RequestAuthParams{
+			UserID: input.EmailUserID,
+			Scopes: []string{"user:read:email"},
+		}
+	}
+
+	var output GetUsersOutput
+
+	metadata, err := r.client.doRequest(req, &output, authParams)
+	output.ResponseMetadata = metadata
+
+	if err != nil {
+		return output, err
+	}
+
+	return output, nil
+}
+
+type (
+	UpdateUserInput struct {
+		UserID      string
+		Description string
+	}
+
+	UpdateUserOutput struct {
+		User             User
+		ResponseMetadata api.ResponseMetadata
+	}
+)
+
+// UpdateUser updates the specified user’s information (only the description can be
+// updated). To remove the description, specify it as an empty string.
+//
+// Reference: https://dev.twitch.tv/docs/api/reference/#update-user
+//
+// Requires a user access token that includes the user:edit scope.
+func (r UsersResource) UpdateUser(ctx context.Context, input UpdateUserInput) (UpdateUserOutput, error) {
+	const resource = "users"
+
+	values := url.Values{}
+	values.Set("description", input.Description)
+
+	req, err := httpcore.NewAPIRequest(ctx, httpcore.RequestOptions{
+		APIType:   api.TypeHelix,
+		Resource:  resource,
+		Method:    http.MethodPut,
+		URLValues: values,
+	}, false)
+	if err != nil {
+		return UpdateUserOutput{}, err
+	}
+
+	var (
+		wrapper GetUsersOutput
+		output  UpdateUserOutput
+	)
+
+	metadata, err := r.client.doRequest(req, &wrapper, RequestAuthParams{
+		UserID: input.UserID,
+		Scopes: []string{"user:edit"},
+	})
+	output.ResponseMetadata = metadata
+
+	if err != nil {
+		return output, err
+	}
+
+	if len(wrapper.Users) != 0 {
+		output.User = wrapper.Users[0]
+	}
+
+	return output, nil
+}
+
+type (
+	BlockedUser struct {
+		UserID      string `json:"user_id"`
+		UserLogin   string `json:"user_login"`
+		DisplayName string `json:"display_name"`
+	}
+
+	GetUserBlockListInput struct {
+		BroadcasterID string
+		First         int
+		After         string
+	}
+
+	GetUserBlockListOutput struct {
+		BlockedUsers     []BlockedUser `json:"data"`
+		Pagination       Pagination    `json:"pagination"`
+		ResponseMetadata api.ResponseMetadata
+	}
+)
+
+// GetUserBlockList gets the list of users that the broadcaster has blocked.
+//
+// Reference: https://dev.twitch.tv/docs/api/reference/#get-user-block-list
+//
+// Requires a user access token that includes the user:read:blocked_users scope.
+func (r UsersResource) GetUserBlockList(
+	ctx context.Context,
+	input GetUserBlockListInput,
+) (GetUserBlockListOutput, error) {
+	const resource = "users/blocks"
+
+	values := url.Values{}
+	values.Set("broadcaster_id", input.BroadcasterID)
+	setQueryInt(values, "first", input.First)
+	setQuery(values, "after", input.After)
+
+	req, err := httpcore.NewAPIRequest(ctx, httpcore.RequestOptions{
+		APIType:   api.TypeHelix,
+		Resource:  resource,
+		Method:    http.MethodGet,
+		URLValues: values,
+	}, false)
+	if err != nil {
+		return GetUserBlockListOutput{}, err
+	}
+
+	var output GetUserBlockListOutput
+
+	metadata, err := r.client.doRequest(req, &output, RequestAuthParams{
+		UserID: input.BroadcasterID,
+		Scopes: []string{"user:read:blocked_users"},
+	})
+	output.ResponseMetadata = metadata
+
+	if err != nil {
+		return output, err
+	}
+
+	return output, nil
+}
+
+// BlockSourceContext is a location where the harassment took place.
+type BlockSourceContext string
+
+const (
+	BlockSourceChat    BlockSourceContext = "chat"
+	BlockSourceWhisper BlockSourceContext = "whisper"
+)
+
+// BlockReason is a reason why the user was blocked.
+type BlockReason string
+
+const (
+	BlockReasonHarassment BlockReason = "harassment"
+	BlockReasonSpam       BlockReason = "spam"
+	BlockReasonOther      BlockReason = "other"
+)
+
+type BlockUserInput struct {
+	// UserID is an ID of the user that blocks the target user.
+	UserID        string
+	TargetUserID  string
+	SourceContext BlockSourceContext
+	Reason        BlockReason
+}
+
+// BlockUser blocks the specified user from interacting with or having contact with the
+// user.
+//
+// Reference: https://dev.twitch.tv/docs/api/reference/#block-user
+//
+// Requires a user access token that includes the user:manage:blocked_users scope.
+func (r UsersResource) BlockUser(ctx context.Context, input BlockUserInput) (api.ResponseMetadata, error) {
+	const resource = "users/blocks"
+
+	values := url.Values{}
+	values.Set("target_user_id", input.TargetUserID)
+	setQuery(values, "source_context", string(input.SourceContext))
+	setQuery(values, "reason", string(input.Reason))
+
+	req, err := httpcore.NewAPIRequest(ctx, httpcore.RequestOptions{
+		APIType:   api.TypeHelix,
+		Resource:  resource,
+		Method:    http.MethodPut,
+		URLValues: values,
+	}, false)
+	if err != nil {
+		return api.ResponseMetadata{}, err
+	}
+
+	return r.client.doRequest(req, nil, RequestAuthParams{
+		UserID: input.UserID,
+		Scopes: []string{"user:manage:blocked_users"},
+	})
+}
+
+type UnblockUserInput struct {
+	// UserID is an ID of the user that unblocks the target user.
+	UserID       string
+	TargetUserID string
+}
+
+// UnblockUser removes the user from the broadcaster’s list of blocked users.
+//
+// Reference: https://dev.twitch.tv/docs/api/reference/#unblock-user
+//
+// Requires a user access token that includes the user:manage:blocked_users scope.
+func (r UsersResource) UnblockUser(ctx context.Context, input UnblockUserInput) (api.ResponseMetadata, error) {
+	const resource = "users/blocks"
+
+	values := url.Values{}
+	values.Set("target_user_id", input.TargetUserID)
+
+	req, err := httpcore.NewAPIRequest(ctx, httpcore.RequestOptions{
+		APIType:   api.TypeHelix,
+		Resource:  resource,
+		Method:    http.MethodDelete,
+		URLValues: values,
+	}, false)
+	if err != nil {
+		return api.ResponseMetadata{}, err
+	}
+
+	return r.client.doRequest(req, nil, RequestAuthParams{
+		UserID: input.UserID,
+		Scopes: []string{"user:manage:blocked_users"},
+	})
+}
+
+type (
+	UserExtension struct {
+		ID          string   `json:"id"`
+		Version     string   `json:"version"`
+		Name        string   `json:"name"`
+		CanActivate bool     `json:"can_activate"`
+		Type        []string `json:"type"`
+	}
+
+	GetUserExtensionsOutput struct {
+		Extensions       []UserExtension `json:"data"`
+		ResponseMetadata api.ResponseMetadata
+	}
+)
+
+// GetUserExtensions gets a list of all extensions (both active and inactive) that the
+// broadcaster has installed.
+//
+// Reference: https://dev.twitch.tv/docs/api/reference/#get-user-extensions
+//
+// Requires a user access token that includes the user:read:broadcast or user:edit:broadcast scope.
+func (r UsersResource) GetUserExtensions(ctx context.Context, userID string) (GetUserExtensionsOutput, error) {
+	const resource = "users/extensions/list"
+
+	req, err := httpcore.NewAPIRequest(ctx, httpcore.RequestOptions{
+		APIType:  api.TypeHelix,
+		Resource: resource,
+		Method:   http.MethodGet,
+	}, false)
+	if err != nil {
+		return GetUserExtensionsOutput{}, err
+	}
+
+	var output GetUserExtensionsOutput
+
+	metadata, err := r.client.doRequest(req, &output, RequestAuthParams{
+		UserID: userID,
+		Scopes: []string{"user:read:broadcast"},
+	})
+	output.ResponseMetadata = metadata
+
+	if err != nil {
+		return output, err
+	}
+
+	return output, nil
+}
+
+type (
+	// ActiveExtensions are extensions installed into the slots of the broadcaster's
+	// channel. Keys of the maps are slot numbers starting from "1".
+	ActiveExtensions struct {
+		Panel     map[string]ActiveExtension `json:"panel,omitempty"`
+		Overlay   map[string]ActiveExtension `json:"overlay,omitempty"`
+		Component map[string]ActiveExtension `json:"component,omitempty"`
+	}
+
+	ActiveExtension struct {
+		Active  bool   `json:"active"`
+		ID      string `json:"id,omitempty"`
+		Version string `json:"version,omitempty"`
+		Name    string `json:"name,omitempty"`
+
+		// X and Y are coordinates of the component extension.
+		X *int `json:"x,omitempty"`
+		Y *int `json:"y,omitempty"`
+	}
+
+	ActiveExtensionsWrapper struct {
+		Data ActiveExtensions `json:"data"`
+	}
+
+	GetUserActiveExtensionsInput struct {
+		// UserID is an ID of the broadcaster whose active extensions should be returned.
+		UserID string
+	}
+
+	ActiveExtensionsOutput struct {
+		ActiveExtensions
+		ResponseMetadata api.ResponseMetadata
+	}
+)
+
+// GetUserActiveExtensions gets the active extensions that the broadcaster has installed
+// for each configuration.
+//
+// Reference: https://dev.twitch.tv/docs/api/reference/#get-user-active-extensions
+//
+// Requires an app access token or user access token.
+func (r UsersResource) GetUserActiveExtensions(
+	ctx context.Context,
+	input GetUserActiveExtensionsInput,
+) (ActiveExtensionsOutput, error) {
+	const resource = "users/extensions"
+
+	values := url.Values{}
+	setQuery(values, "user_id", input.UserID)
+
+	req, err := httpcore.NewAPIRequest(ctx, httpcore.RequestOptions{
+		APIType:   api.TypeHelix,
+		Resource:  resource,
+		Method:    http.MethodGet,
+		URLValues: values,
+	}, false)
+	if err != nil {
+		return ActiveExtensionsOutput{}, err
+	}
+
+	var (
+		wrapper ActiveExtensionsWrapper
+		output  ActiveExtensionsOutput
+	)
+
+	metadata, err := r.client.doRequest(req, &wrapper, RequestAuthParams{
+		UserID: input.UserID,
+	})
+	output.ResponseMetadata = metadata
+
+	if err != nil {
+		return output, err
+	}
+
+	output.ActiveExtensions = wrapper.Data
+
+	return output, nil
+}
+
+type UpdateUserExtensionsInput struct {
+	UserID     string
+	Extensions ActiveExtensions
+}
+
+// UpdateUserExtensions updates an installed extension’s information. You can update the
+// extension’s activation state, ID, and version number.
+//
+// Reference: https://dev.twitch.tv/docs/api/reference/#update-user-extensions
+//
+// Requires a user access token that includes the user:edit:broadcast scope.
+func (r UsersResource) UpdateUserExtensions(
+	ctx context.Context,
+	input UpdateUserExtensionsInput,
+) (ActiveExtensionsOutput, error) {
+	const resource = "users/extensions"
+
+	req, err := httpcore.NewAPIRequest(ctx, httpcore.RequestOptions{
+		APIType:  api.TypeHelix,
+		Resource: resource,
+		Method:   http.MethodPut,
+		Body:     ActiveExtensionsWrapper{Data: input.Extensions},
+	}, true)
+	if err != nil {
+		return ActiveExtensionsOutput{}, err
+	}
+
+	var (
+		wrapper ActiveExtensionsWrapper
+		output  ActiveExtensionsOutput
+	)
+
+	metadata, err := r.client.doRequest(req, &wrapper, RequestAuthParams{
+		UserID: input.UserID,
+		Scopes: []string{"user:edit:broadcast"},
+	})
+	output.ResponseMetadata = metadata
+
+	if err != nil {
+		return output, err
+	}
+
+	output.ActiveExtensions = wrapper.Data
+
+	return output, nil
+}
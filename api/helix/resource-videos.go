@@ -0,0 +1,209 @@
+package helix
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/kvizyx/twitchkit/api"
+	"github.com/kvizyx/twitchkit/http-core"
+)
+
+const (
+	maxVideosPerRequest       = 100
+	maxDeleteVideosPerRequest = 5
+)
+
+type VideosResource struct {
+	client Client
+}
+
+func (c Client) Videos() VideosResource {
+	return VideosResource{client: c}
+}
+
+// VideoPeriod is a time period of the videos to return.
+type VideoPeriod string
+
+const (
+	VideoPeriodAll   VideoPeriod = "all"
+	VideoPeriodDay   VideoPeriod = "day"
+	VideoPeriodMonth VideoPeriod = "month"
+	VideoPeriodWeek  VideoPeriod = "week"
+)
+
+// VideoSort is an order of the videos in the list.
+type VideoSort string
+
+const (
+	VideoSortTime     VideoSort = "time"
+	VideoSortTrending VideoSort = "trending"
+	VideoSortViews    VideoSort = "views"
+)
+
+// VideoType is a type of the video.
+type VideoType string
+
+const (
+	VideoTypeAll       VideoType = "all"
+	VideoTypeArchive   VideoType = "archive"
+	VideoTypeHighlight VideoType = "highlight"
+	VideoTypeUpload    VideoType = "upload"
+)
+
+type (
+	Video struct {
+		ID           string    `json:"id"`
+		StreamID     string    `json:"stream_id"`
+		UserID       string    `json:"user_id"`
+		UserLogin    string    `json:"user_login"`
+		UserName     string    `json:"user_name"`
+		Title        string    `json:"title"`
+		Description  string    `json:"description"`
+		CreatedAt    time.Time `json:"created_at"`
+		PublishedAt  time.Time `json:"published_at"`
+		URL          string    `json:"url"`
+		ThumbnailURL string    `json:"thumbnail_url"`
+		Viewable     string    `json:"viewable"`
+		ViewCount    int       `json:"view_count"`
+		Language     string    `json:"language"`
+		Type         VideoType `json:"type"`
+
+		// Duration is in ISO 8601 format (e.g. "3m21s").
+		Duration      string              `json:"duration"`
+		MutedSegments []VideoMutedSegment `json:"muted_segments"`
+	}
+
+	VideoMutedSegment struct {
+		Duration int `json:"duration"`
+		Offset   int `json:"offset"`
+	}
+
+	GetVideosInput struct {
+		// IDs, UserID and GameID are mutually exclusive.
+		IDs    []string
+		UserID string
+		GameID string
+
+		// Language, Period, Sort and Type can be used only with UserID or GameID.
+		Language string
+		Period   VideoPeriod
+		Sort     VideoSort
+		Type     VideoType
+		First    int
+		After    string
+		Before   string
+	}
+
+	GetVideosOutput struct {
+		Videos           []Video    `json:"data"`
+		Pagination       Pagination `json:"pagination"`
+		ResponseMetadata api.ResponseMetadata
+	}
+)
+
+// GetVideos gets information about one or more published videos.
+//
+// Reference: https://dev.twitch.tv/docs/api/reference/#get-videos
+//
+// Requires an app access token or user access token.
+func (r VideosResource) GetVideos(ctx context.Context, input GetVideosInput) (GetVideosOutput, error) {
+	const resource = "videos"
+
+	if len(input.IDs) > maxVideosPerRequest {
+		return GetVideosOutput{}, TooManyItemsError("IDs", maxVideosPerRequest)
+	}
+
+	values := url.Values{}
+	addQuery(values, "id", input.IDs)
+	setQuery(values, "user_id", input.UserID)
+	setQuery(values, "game_id", input.GameID)
+	setQuery(values, "language", input.Language)
+	setQuery(values, "period", string(input.Period))
+	setQuery(values, "sort", string(input.Sort))
+	setQuery(values, "type", string(input.Type))
+	setQueryInt(values, "first", input.First)
+	setQuery(values, "after", input.After)
+	setQuery(values, "before", input.Before)
+
+	req, err := httpcore.NewAPIRequest(ctx, httpcore.RequestOptions{
+		APIType:   api.TypeHelix,
+		Resource:  resource,
+		Method:    http.MethodGet,
+		URLValues: values,
+	}, false)
+	if err != nil {
+		return GetVideosOutput{}, err
+	}
+
+	var output GetVideosOutput
+
+	metadata, err := r.client.doRequest(req, &output, RequestAuthParams{})
+	output.ResponseMetadata = metadata
+
+	if err != nil {
+		return output, err
+	}
+
+	return output, nil
+}
+
+type (
+	DeleteVideosInput struct {
+		// UserID is an ID of the broadcaster or editor who owns the videos. User access
+		// token of this user is used for the request.
+		UserID string
+		IDs    []string
+	}
+
+	DeleteVideosOutput struct {
+		DeletedIDs       []string `json:"data"`
+		ResponseMetadata api.ResponseMetadata
+	}
+)
+
+// DeleteVideos deletes one or more videos (up to 5). You may delete past broadcasts,
+// highlights, or uploads.
+//
+// Reference: https://dev.twitch.tv/docs/api/reference/#delete-videos
+//
+// Requires a user access token that includes the channel:manage:videos scope.
+func (r VideosResource) DeleteVideos(ctx context.Context, input DeleteVideosInput) (DeleteVideosOutput, error) {
+	const resource = "videos"
+
+	if len(input.IDs) == 0 {
+		return DeleteVideosOutput{}, MissingInputError("IDs")
+	}
+
+	if len(input.IDs) > maxDeleteVideosPerRequest {
+		return DeleteVideosOutput{}, TooManyItemsError("IDs", maxDeleteVideosPerRequest)
+	}
+
+	values := url.Values{}
+	addQuery(values, "id", input.IDs)
+
+	req, err := httpcore.NewAPIRequest(ctx, httpcore.RequestOptions{
+		APIType:   api.TypeHelix,
+		Resource:  resource,
+		Method:    http.MethodDelete,
+		URLValues: values,
+	}, false)
+	if err != nil {
+		return DeleteVideosOutput{}, err
+	}
+
+	var output DeleteVideosOutput
+
+	metadata, err := r.client.doRequest(req, &output, RequestAuthParams{
+		UserID: input.UserID,
+		Scopes: []string{"channel:manage:videos"},
+	})
+	output.ResponseMetadata = metadata
+
+	if err != nil {
+		return output, err
+	}
+
+	return output, nil
+}
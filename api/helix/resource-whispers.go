@@ -0,0 +1,102 @@
+package helix
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/kvizyx/twitchkit/api"
+	"github.com/kvizyx/twitchkit/http-core"
+)
+
+type WhispersResource struct {
+	client Client
+}
+
+func (c Client) Whispers() WhispersResource {
+	return WhispersResource{client: c}
+}
+
+type SendWhisperInput struct {
+	FromUserID string `json:"-"`
+	ToUserID   string `json:"-"`
+
+	// Message is a whisper message to send. Messages longer than 500 characters (or
+	// 10000 characters, if the recipient has never been whispered before) are truncated
+	// by Twitch.
+	Message string `json:"message"`
+}
+
+// SendWhisper sends a whisper message to the specified user. Whispers are limited per
+// sender by the client's WhisperLimiter, so it may block until the whisper is allowed.
+// Error wrapping ErrUnverifiedPhone is returned if the sender doesn't have a verified
+// phone number.
+//
+// Reference: https://dev.twitch.tv/docs/api/reference/#send-whisper
+//
+// Requires a user access token that includes the user:manage:whispers scope.
+func (r WhispersResource) SendWhisper(ctx context.Context, input SendWhisperInput) (api.ResponseMetadata, error) {
+	const resource = "whispers"
+
+	if err := r.client.whisperLimiter.Wait(ctx, input.FromUserID, input.ToUserID); err != nil {
+		return api.ResponseMetadata{}, err
+	}
+
+	sent := false
+	defer func() {
+		r.client.whisperLimiter.Finish(input.FromUserID, input.ToUserID, sent)
+	}()
+
+	values := url.Values{}
+	values.Set("from_user_id", input.FromUserID)
+	values.Set("to_user_id", input.ToUserID)
+
+	req, err := httpcore.NewAPIRequest(ctx, httpcore.RequestOptions{
+		APIType:   api.TypeHelix,
+		Resource:  resource,
+		Method:    http.MethodPost,
+		URLValues: values,
+		Body:      input,
+	}, true)
+	if err != nil {
+		return api.ResponseMetadata{}, err
+	}
+
+	metadata, err := r.client.doRequest(req, nil, RequestAuthParams{
+		UserID: input.FromUserID,
+		Scopes: []string{"user:manage:whispers"},
+	})
+	if err != nil {
+		return metadata, whisperError(metadata, err)
+	}
+
+	sent = true
+
+	return metadata, nil
+}
+
+// whisperError wraps whisper specific failures into typed errors along with the
+// request error.
+func whisperError(metadata api.ResponseMetadata, err error) error {
+	switch metadata.StatusCode {
+	case http.StatusUnauthorized:
+		if isUnverifiedPhone(metadata) {
+			return fmt.Errorf("%w: %w", UnverifiedPhoneError(metadata.TwitchMessage), err)
+		}
+	case http.StatusForbidden:
+		return fmt.Errorf("%w: %w", WhisperNotAllowedError(metadata.TwitchMessage), err)
+	case http.StatusTooManyRequests:
+		return fmt.Errorf("%w: %w", WhisperLimitError(metadata.TwitchMessage), err)
+	}
+
+	return err
+}
+
+// isUnverifiedPhone reports whether the request is unauthorized because the user doesn't
+// have a verified phone number, so it can't be fixed by a fresh token.
+func isUnverifiedPhone(metadata api.ResponseMetadata) bool {
+	return metadata.StatusCode == http.StatusUnauthorized &&
+		strings.Contains(strings.ToLower(metadata.TwitchMessage), "phone")
+}
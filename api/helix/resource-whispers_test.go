@@ -0,0 +1,80 @@
+package helix
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/kvizyx/twitchkit/http-core"
+)
+
+func TestSendWhisperErrors(t *testing.T) {
+	tests := []struct {
+		name      string
+		response  fakeResponse
+		wantErr   error
+		wantSent  int
+		refreshes int
+	}{
+		{
+			name: "unverified phone",
+			response: fakeResponse{
+				status: http.StatusUnauthorized,
+				body:   `{"error":"Unauthorized","status":401,"message":"the sender does not have a verified phone number"}`,
+			},
+			wantErr:  ErrUnverifiedPhone,
+			wantSent: 1,
+		},
+		{
+			name: "not allowed",
+			response: fakeResponse{
+				status: http.StatusForbidden,
+				body:   `{"error":"Forbidden","status":403,"message":"the user cannot whisper"}`,
+			},
+			wantErr:  ErrWhisperNotAllowed,
+			wantSent: 1,
+		},
+		{
+			name: "invalid token",
+			response: fakeResponse{
+				status: http.StatusUnauthorized,
+				body:   `{"error":"Unauthorized","status":401,"message":"invalid access token"}`,
+			},
+			wantErr:   httpcore.ErrUnsuccessfulRequest,
+			wantSent:  2,
+			refreshes: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			httpClient := &fakeHTTPClient{responses: []fakeResponse{tt.response}}
+			provider := &fakeAuthProvider{}
+			client := newTestClient(t, httpClient, provider)
+
+			_, err := client.Whispers().SendWhisper(context.Background(), SendWhisperInput{
+				FromUserID: "1",
+				ToUserID:   "2",
+				Message:    "hello",
+			})
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("err = %v, want %v", err, tt.wantErr)
+			}
+
+			// typed error keeps the request error, so it can be inspected too.
+			if !errors.Is(err, httpcore.ErrUnsuccessfulRequest) {
+				t.Errorf("err = %v, want it to wrap %v", err, httpcore.ErrUnsuccessfulRequest)
+			}
+
+			if sent := httpClient.requests(); len(sent) != tt.wantSent {
+				t.Errorf("sent %d requests, want %d", len(sent), tt.wantSent)
+			}
+
+			if provider.refreshes != tt.refreshes {
+				t.Errorf("refreshes = %d, want %d", provider.refreshes, tt.refreshes)
+			}
+		})
+	}
+}
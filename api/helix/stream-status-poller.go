@@ -0,0 +1,161 @@
+package helix
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// StreamStatusChange describes transition of the channel between online and offline.
+type StreamStatusChange struct {
+	UserID string
+	Online bool
+
+	// Stream is the current stream if channel went online, and the last known stream
+	// if it went offline.
+	Stream Stream
+}
+
+// StreamStatusPoller detects online/offline transitions of the set of channels by
+// polling GetStreams. It's a replacement for EventSub stream.online and stream.offline
+// subscriptions for setups where EventSub is not available.
+type StreamStatusPoller struct {
+	streams StreamsResource
+	userIDs []string
+
+	live       map[string]Stream
+	polledOnce bool
+	locker     sync.Mutex
+}
+
+// NewStreamStatusPoller creates StreamStatusPoller for channels with given user IDs.
+func (r StreamsResource) NewStreamStatusPoller(userIDs []string) *StreamStatusPoller {
+	return &StreamStatusPoller{
+		streams: r,
+		userIDs: userIDs,
+		live:    make(map[string]Stream),
+	}
+}
+
+// Live returns streams that were online at the last poll.
+func (sp *StreamStatusPoller) Live() []Stream {
+	sp.locker.Lock()
+	defer sp.locker.Unlock()
+
+	streams := make([]Stream, 0, len(sp.live))
+	for _, stream := range sp.live {
+		streams = append(streams, stream)
+	}
+
+	return streams
+}
+
+// Poll gets current streams of the channels and returns changes since the previous
+// poll. On the first poll every online channel is reported as gone online, as there
+// is no previous state.
+func (sp *StreamStatusPoller) Poll(ctx context.Context) ([]StreamStatusChange, error) {
+	sp.locker.Lock()
+	defer sp.locker.Unlock()
+
+	current, err := sp.fetchLive(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var changes []StreamStatusChange
+
+	for userID, stream := range current {
+		if _, wasLive := sp.live[userID]; !wasLive {
+			changes = append(changes, StreamStatusChange{
+				UserID: userID,
+				Online: true,
+				Stream: stream,
+			})
+		}
+	}
+
+	if sp.polledOnce {
+		for userID, stream := range sp.live {
+			if _, isLive := current[userID]; !isLive {
+				changes = append(changes, StreamStatusChange{
+					UserID: userID,
+					Online: false,
+					Stream: stream,
+				})
+			}
+		}
+	}
+
+	sp.live = current
+	sp.polledOnce = true
+
+	return changes, nil
+}
+
+// Run polls channels with the given interval until context is done and calls onChange
+// for every detected change. Failed polls are reported to onError (if it's not nil)
+// and do not stop polling. Interval must be positive.
+func (sp *StreamStatusPoller) Run(
+	ctx context.Context,
+	interval time.Duration,
+	onChange func(change StreamStatusChange),
+	onError func(err error),
+) error {
+	if interval <= 0 {
+		return InvalidIntervalError(interval)
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		changes, err := sp.Poll(ctx)
+		if err != nil && onError != nil {
+			onError(err)
+		}
+
+		for _, change := range changes {
+			onChange(change)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+func (sp *StreamStatusPoller) fetchLive(ctx context.Context) (map[string]Stream, error) {
+	live := make(map[string]Stream)
+
+	for start := 0; start < len(sp.userIDs); start += maxStreamFiltersPerRequest {
+		end := min(start+maxStreamFiltersPerRequest, len(sp.userIDs))
+
+		input := GetStreamsInput{
+			UserIDs: sp.userIDs[start:end],
+			Type:    StreamTypeLive,
+			First:   maxStreamFiltersPerRequest,
+		}
+
+		for {
+			output, err := sp.streams.GetStreams(ctx, input)
+			if err != nil {
+				return nil, fmt.Errorf("get streams: %w", err)
+			}
+
+			for _, stream := range output.Streams {
+				live[stream.UserID] = stream
+			}
+
+			if len(output.Pagination.Cursor) == 0 || len(output.Streams) == 0 {
+				break
+			}
+
+			input.After = output.Pagination.Cursor
+		}
+	}
+
+	return live, nil
+}
@@ -0,0 +1,151 @@
+package helix
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Twitch whisper limits per sending user.
+//
+// Reference: https://dev.twitch.tv/docs/api/reference/#send-whisper
+const (
+	WhispersPerSecond       = 3
+	WhispersPerMinute       = 100
+	WhisperRecipientsPerDay = 40
+)
+
+// WhisperLimiter keeps whispers of every sender within Twitch limits. Rate limits per
+// second and minute are waited out, while exceeding the daily limit of unique recipients
+// is reported with ErrWhisperLimit, as waiting for it is not practical.
+//
+// It's safe for concurrent use, but limits are tracked only within the single process.
+type WhisperLimiter struct {
+	senders map[string]*whisperSender
+	locker  sync.Mutex
+	now     func() time.Time
+}
+
+type whisperSender struct {
+	sentAt     []time.Time
+	recipients map[string]time.Time
+
+	// pending counts whispers to the new recipients that are being sent, so they are
+	// not counted toward the daily limit until they are delivered.
+	pending map[string]int
+}
+
+func NewWhisperLimiter() *WhisperLimiter {
+	return &WhisperLimiter{
+		senders: make(map[string]*whisperSender),
+		now:     time.Now,
+	}
+}
+
+// Wait blocks until whisper from one user to another is allowed or context is done. The
+// whisper is counted toward rate limits once Wait returns nil, and Finish must be called
+// with result of the request after that.
+func (wl *WhisperLimiter) Wait(ctx context.Context, fromUserID, toUserID string) error {
+	for {
+		delay, err := wl.reserve(fromUserID, toUserID)
+		if err != nil {
+			return err
+		}
+
+		if delay <= 0 {
+			return nil
+		}
+
+		timer := time.NewTimer(delay)
+
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// Finish reports result of the whisper allowed by Wait. The recipient is counted toward
+// the daily limit of unique recipients only if the whisper was sent.
+func (wl *WhisperLimiter) Finish(fromUserID, toUserID string, sent bool) {
+	wl.locker.Lock()
+	defer wl.locker.Unlock()
+
+	sender, ok := wl.senders[fromUserID]
+	if !ok {
+		return
+	}
+
+	if pending, ok := sender.pending[toUserID]; ok {
+		if pending <= 1 {
+			delete(sender.pending, toUserID)
+		} else {
+			sender.pending[toUserID] = pending - 1
+		}
+	}
+
+	if _, known := sender.recipients[toUserID]; sent && !known {
+		sender.recipients[toUserID] = wl.now()
+	}
+}
+
+// reserve counts the whisper toward rate limits if it's allowed now, otherwise it returns
+// how long to wait before the next attempt.
+func (wl *WhisperLimiter) reserve(fromUserID, toUserID string) (time.Duration, error) {
+	wl.locker.Lock()
+	defer wl.locker.Unlock()
+
+	now := wl.now()
+
+	sender, ok := wl.senders[fromUserID]
+	if !ok {
+		sender = &whisperSender{
+			recipients: make(map[string]time.Time),
+			pending:    make(map[string]int),
+		}
+		wl.senders[fromUserID] = sender
+	}
+
+	for recipient, firstSentAt := range sender.recipients {
+		if now.Sub(firstSentAt) >= 24*time.Hour {
+			delete(sender.recipients, recipient)
+		}
+	}
+
+	// whispers that are being sent may add new recipients, so they are counted too.
+	_, knownRecipient := sender.recipients[toUserID]
+	_, pendingRecipient := sender.pending[toUserID]
+
+	if !knownRecipient && !pendingRecipient &&
+		len(sender.recipients)+len(sender.pending) >= WhisperRecipientsPerDay {
+		return 0, WhisperLimitError("daily limit of unique recipients is reached")
+	}
+
+	// sentAt holds whispers within the last minute, which also covers the last second.
+	keepFrom := 0
+	for keepFrom < len(sender.sentAt) && now.Sub(sender.sentAt[keepFrom]) >= time.Minute {
+		keepFrom++
+	}
+	sender.sentAt = sender.sentAt[keepFrom:]
+
+	if len(sender.sentAt) >= WhispersPerMinute {
+		return sender.sentAt[len(sender.sentAt)-WhispersPerMinute].Add(time.Minute).Sub(now), nil
+	}
+
+	if len(sender.sentAt) >= WhispersPerSecond {
+		oldest := sender.sentAt[len(sender.sentAt)-WhispersPerSecond]
+		if now.Sub(oldest) < time.Second {
+			return oldest.Add(time.Second).Sub(now), nil
+		}
+	}
+
+	sender.sentAt = append(sender.sentAt, now)
+
+	if !knownRecipient {
+		sender.pending[toUserID]++
+	}
+
+	return 0, nil
+}
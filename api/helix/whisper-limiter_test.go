@@ -0,0 +1,83 @@
+package helix
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestWhisperLimiterCountsDeliveredRecipients(t *testing.T) {
+	limiter := NewWhisperLimiter()
+
+	// every whisper is a minute apart, so rate limits are never hit.
+	now := time.Now()
+	limiter.now = func() time.Time {
+		now = now.Add(time.Minute)
+		return now
+	}
+
+	ctx := context.Background()
+
+	// failed whispers don't use up unique recipients.
+	for i := range 2 * WhisperRecipientsPerDay {
+		recipient := fmt.Sprintf("failed-%d", i)
+
+		if err := limiter.Wait(ctx, "1", recipient); err != nil {
+			t.Fatalf("wait for failed whisper %d: %v", i, err)
+		}
+
+		limiter.Finish("1", recipient, false)
+	}
+
+	for i := range WhisperRecipientsPerDay {
+		recipient := fmt.Sprintf("sent-%d", i)
+
+		if err := limiter.Wait(ctx, "1", recipient); err != nil {
+			t.Fatalf("wait for whisper %d: %v", i, err)
+		}
+
+		limiter.Finish("1", recipient, true)
+	}
+
+	if err := limiter.Wait(ctx, "1", "one-more"); !errors.Is(err, ErrWhisperLimit) {
+		t.Fatalf("err = %v, want %v", err, ErrWhisperLimit)
+	}
+
+	// known recipients can still be whispered.
+	if err := limiter.Wait(ctx, "1", "sent-0"); err != nil {
+		t.Fatalf("wait for known recipient: %v", err)
+	}
+
+	limiter.Finish("1", "sent-0", true)
+}
+
+func TestWhisperLimiterCountsPendingRecipients(t *testing.T) {
+	limiter := NewWhisperLimiter()
+
+	now := time.Now()
+	limiter.now = func() time.Time {
+		now = now.Add(time.Minute)
+		return now
+	}
+
+	ctx := context.Background()
+
+	// whispers being sent reserve their recipients until they are finished.
+	for i := range WhisperRecipientsPerDay {
+		if err := limiter.Wait(ctx, "1", fmt.Sprintf("pending-%d", i)); err != nil {
+			t.Fatalf("wait for whisper %d: %v", i, err)
+		}
+	}
+
+	if err := limiter.Wait(ctx, "1", "one-more"); !errors.Is(err, ErrWhisperLimit) {
+		t.Fatalf("err = %v, want %v", err, ErrWhisperLimit)
+	}
+
+	limiter.Finish("1", "pending-0", false)
+
+	if err := limiter.Wait(ctx, "1", "one-more"); err != nil {
+		t.Fatalf("wait after failed whisper: %v", err)
+	}
+}
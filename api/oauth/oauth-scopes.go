@@ -11,7 +11,20 @@ var equalScopes = map[string][]string{
 	"user_follows_edit":     {"user:edit:follows"},
 	"user_read":             {"user:read:email"},
 	"user_subscriptions":    {"user:read:subscriptions"},
-	"user:edit:broadcast":   {"channel:manage:broadcast", "channel:manage:extensions"},
+	"user:edit:broadcast":   {"channel:manage:broadcast", "channel:manage:extensions", "user:read:broadcast"},
+
+	// manage scopes also grant read access to the same resource.
+	"channel:manage:guest_star":         {"channel:read:guest_star"},
+	"channel:manage:polls":              {"channel:read:polls"},
+	"channel:manage:predictions":        {"channel:read:predictions"},
+	"channel:manage:redemptions":        {"channel:read:redemptions"},
+	"channel:manage:vips":               {"channel:read:vips"},
+	"moderator:manage:automod_settings": {"moderator:read:automod_settings"},
+	"moderator:manage:blocked_terms":    {"moderator:read:blocked_terms"},
+	"moderator:manage:chat_settings":    {"moderator:read:chat_settings"},
+	"moderator:manage:guest_star":       {"moderator:read:guest_star"},
+	"moderator:manage:shield_mode":      {"moderator:read:shield_mode"},
+	"moderator:manage:unban_requests":   {"moderator:read:unban_requests"},
 }
 
 // IsScopesEqual compares given scopes and returns whether scopes are equal.
@@ -8,6 +8,7 @@ import (
 
 	"github.com/kvizyx/twitchkit/api"
 	"github.com/kvizyx/twitchkit/api/oauth"
+	"github.com/kvizyx/twitchkit/telemetry"
 )
 
 const authorizationType = api.AuthTypeBearer
@@ -34,6 +35,9 @@ type RefreshingProvider struct {
 
 	cbOnRefresh        OnRefreshCallback
 	cbOnRefreshFailure OnRefreshFailureCallback
+
+	tracer  telemetry.Tracer
+	metrics telemetry.Metrics
 }
 
 var (
@@ -46,6 +50,10 @@ type RefreshingProviderParams struct {
 	ClientSecret string
 	RedirectURI  string
 	Scopes       []string
+
+	// Tracer and Metrics are optional telemetry hooks for token refreshes.
+	Tracer  telemetry.Tracer
+	Metrics telemetry.Metrics
 }
 
 func NewRefreshingProvider(p RefreshingProviderParams) *RefreshingProvider {
@@ -55,6 +63,8 @@ func NewRefreshingProvider(p RefreshingProviderParams) *RefreshingProvider {
 		redirectURI:  p.RedirectURI,
 		scopes:       p.Scopes,
 		users:        make(map[string]oauth.UserAccessToken),
+		tracer:       telemetry.TracerOrNop(p.Tracer),
+		metrics:      telemetry.MetricsOrNop(p.Metrics),
 	}
 }
 
@@ -256,7 +266,7 @@ func (ap *RefreshingProvider) RefreshUnknownUserAccessToken(
 ) (oauth.AccessTokenWithInfo, error) {
 	var token oauth.AccessTokenWithInfo
 
-	err := ap.withRefreshCallbacks(func() error {
+	err := ap.withRefreshCallbacks(ctx, func(ctx context.Context) error {
 		var err error
 
 		token, err = ap.refreshUnknownUserToken(ctx, refreshToken)
@@ -280,7 +290,7 @@ func (ap *RefreshingProvider) RefreshUserAccessToken(
 ) (oauth.UserAccessToken, error) {
 	var token oauth.UserAccessToken
 
-	err := ap.withRefreshCallbacks(func() error {
+	err := ap.withRefreshCallbacks(ctx, func(ctx context.Context) error {
 		var err error
 
 		token, err = ap.refreshUserToken(ctx, userID)
@@ -367,11 +377,22 @@ func (ap *RefreshingProvider) refreshUserToken(
 }
 
 func (ap *RefreshingProvider) withRefreshCallbacks(
-	refresh func() error,
+	ctx context.Context,
+	refresh func(ctx context.Context) error,
 	token oauth.AccessToken,
 	userID string,
 ) error {
-	if err := refresh(); err != nil {
+	ctx, span := ap.tracer.Start(ctx, "refresh user access token",
+		telemetry.String("twitch.user_id", userID),
+	)
+	defer span.End()
+
+	err := refresh(ctx)
+	ap.metrics.RecordRefresh(ctx, err)
+
+	if err != nil {
+		span.RecordError(err)
+
 		if ap.cbOnRefreshFailure == nil {
 			return err
 		}
@@ -0,0 +1,145 @@
+package httpcore
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"log/slog"
+	"net/http"
+	"time"
+)
+
+const (
+	DefaultRequestIDHeader = "X-Request-ID"
+
+	redactedValue = "[REDACTED]"
+)
+
+// redactedHeaders are headers whose values never get to the logs.
+var redactedHeaders = []string{
+	"Authorization",
+	"Client-Secret",
+}
+
+// HTTPClientFunc is an adapter to allow the use of ordinary functions as HTTPClient.
+type HTTPClientFunc func(req *http.Request) (*http.Response, error)
+
+func (f HTTPClientFunc) Do(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// Middleware wraps HTTPClient to run some code around its Do method.
+type Middleware func(next HTTPClient) HTTPClient
+
+// Chain wraps client with given middlewares. The first middleware is the outermost
+// one, so it sees the request first and the response last.
+func Chain(client HTTPClient, middlewares ...Middleware) HTTPClient {
+	for i := len(middlewares) - 1; i >= 0; i-- {
+		if middlewares[i] == nil {
+			continue
+		}
+
+		client = middlewares[i](client)
+	}
+
+	return client
+}
+
+// LoggingMiddleware logs every request with its outcome to the given logger. Successful
+// requests are logged at debug level, non-2xx responses at warn level and failed requests
+// at error level. Values of sensitive headers such as Authorization and Client-Secret are
+// redacted.
+func LoggingMiddleware(logger *slog.Logger) Middleware {
+	if logger == nil {
+		logger = slog.Default()
+	}
+
+	return func(next HTTPClient) HTTPClient {
+		return HTTPClientFunc(func(req *http.Request) (*http.Response, error) {
+			start := time.Now()
+
+			res, err := next.Do(req)
+
+			attrs := []slog.Attr{
+				slog.String("method", req.Method),
+				slog.String("url", req.URL.Redacted()),
+				slog.Any("header", RedactHeader(req.Header)),
+				slog.Duration("duration", time.Since(start)),
+			}
+
+			if err != nil {
+				attrs = append(attrs, slog.String("error", err.Error()))
+				logger.LogAttrs(req.Context(), slog.LevelError, "twitch request failed", attrs...)
+
+				return res, err
+			}
+
+			level := slog.LevelDebug
+			if res.StatusCode < 200 || res.StatusCode > 299 {
+				level = slog.LevelWarn
+			}
+
+			attrs = append(attrs, slog.Int("status", res.StatusCode))
+			logger.LogAttrs(req.Context(), level, "twitch request", attrs...)
+
+			return res, nil
+		})
+	}
+}
+
+// RequestIDMiddleware sets unique request ID to the given header of every request that
+// does not have one yet. DefaultRequestIDHeader is used if header is empty, and random
+// hex string is generated if generate is nil.
+func RequestIDMiddleware(header string, generate func() string) Middleware {
+	if len(header) == 0 {
+		header = DefaultRequestIDHeader
+	}
+
+	if generate == nil {
+		generate = randomRequestID
+	}
+
+	return func(next HTTPClient) HTTPClient {
+		return HTTPClientFunc(func(req *http.Request) (*http.Response, error) {
+			if len(req.Header.Get(header)) != 0 {
+				return next.Do(req)
+			}
+
+			req = req.Clone(req.Context())
+			req.Header.Set(header, generate())
+
+			return next.Do(req)
+		})
+	}
+}
+
+// UserAgentMiddleware sets User-Agent header of every request to the given value.
+func UserAgentMiddleware(userAgent string) Middleware {
+	return func(next HTTPClient) HTTPClient {
+		return HTTPClientFunc(func(req *http.Request) (*http.Response, error) {
+			req = req.Clone(req.Context())
+			req.Header.Set("User-Agent", userAgent)
+
+			return next.Do(req)
+		})
+	}
+}
+
+// RedactHeader returns copy of the given header with values of sensitive headers replaced.
+func RedactHeader(header http.Header) http.Header {
+	redacted := header.Clone()
+
+	for _, key := range redactedHeaders {
+		if _, ok := redacted[http.CanonicalHeaderKey(key)]; ok {
+			redacted.Set(key, redactedValue)
+		}
+	}
+
+	return redacted
+}
+
+func randomRequestID() string {
+	id := make([]byte, 16)
+	_, _ = rand.Read(id)
+
+	return hex.EncodeToString(id)
+}
@@ -0,0 +1,113 @@
+package httpcore
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestLoggingMiddleware(t *testing.T) {
+	tests := []struct {
+		name      string
+		status    int
+		wantLevel string
+	}{
+		{name: "success", status: http.StatusOK, wantLevel: "DEBUG"},
+		{name: "no content", status: http.StatusNoContent, wantLevel: "DEBUG"},
+		{name: "unauthorized", status: http.StatusUnauthorized, wantLevel: "WARN"},
+		{name: "unavailable", status: http.StatusServiceUnavailable, wantLevel: "WARN"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var output bytes.Buffer
+
+			logger := slog.New(slog.NewJSONHandler(&output, &slog.HandlerOptions{Level: slog.LevelDebug}))
+
+			client := Chain(HTTPClientFunc(func(req *http.Request) (*http.Response, error) {
+				return &http.Response{StatusCode: tt.status, Request: req}, nil
+			}), LoggingMiddleware(logger))
+
+			req, err := http.NewRequest(http.MethodPost, "https://id.twitch.tv/oauth2/token", nil)
+			if err != nil {
+				t.Fatalf("new request: %v", err)
+			}
+
+			req.Header.Set("Authorization", "Bearer secret-token")
+			req.Header.Set("client-secret", "secret-value")
+			req.Header.Set("Client-Id", "client-id")
+
+			if _, err := client.Do(req); err != nil {
+				t.Fatalf("do: %v", err)
+			}
+
+			if strings.Contains(output.String(), "secret-") {
+				t.Fatalf("log contains secret: %s", output.String())
+			}
+
+			var record struct {
+				Level  string              `json:"level"`
+				Status int                 `json:"status"`
+				Header map[string][]string `json:"header"`
+			}
+
+			if err := json.Unmarshal(output.Bytes(), &record); err != nil {
+				t.Fatalf("decode log record: %v", err)
+			}
+
+			if record.Level != tt.wantLevel {
+				t.Errorf("level = %s, want %s", record.Level, tt.wantLevel)
+			}
+
+			if record.Status != tt.status {
+				t.Errorf("status = %d, want %d", record.Status, tt.status)
+			}
+
+			for key, want := range map[string]string{
+				"Authorization": redactedValue,
+				"Client-Secret": redactedValue,
+				"Client-Id":     "client-id",
+			} {
+				if got := record.Header[key]; len(got) != 1 || got[0] != want {
+					t.Errorf("header %s = %q, want [%q]", key, got, want)
+				}
+			}
+
+			// request itself keeps the original values.
+			if got := req.Header.Get("Authorization"); got != "Bearer secret-token" {
+				t.Errorf("request authorization = %q", got)
+			}
+		})
+	}
+}
+
+func TestRedactHeader(t *testing.T) {
+	header := http.Header{}
+	header.Set("Authorization", "OAuth token")
+	header.Set("Client-Secret", "secret")
+	header.Set("Accept", "application/json")
+
+	redacted := RedactHeader(header)
+
+	for key, want := range map[string]string{
+		"Authorization": redactedValue,
+		"Client-Secret": redactedValue,
+		"Accept":        "application/json",
+	} {
+		if got := redacted.Get(key); got != want {
+			t.Errorf("redacted %s = %q, want %q", key, got, want)
+		}
+	}
+
+	if got := header.Get("Authorization"); got != "OAuth token" {
+		t.Errorf("original authorization = %q, want it untouched", got)
+	}
+
+	// headers that are not set are not added.
+	if _, ok := RedactHeader(http.Header{})["Authorization"]; ok {
+		t.Error("redacted empty header has Authorization")
+	}
+}
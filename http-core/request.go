@@ -9,6 +9,7 @@ import (
 	"io"
 	"net/http"
 	"net/url"
+	"strings"
 
 	"github.com/kvizyx/twitchkit/api"
 )
@@ -21,6 +22,7 @@ const (
 var (
 	ErrUnknownBody          = errors.New("unknown body type")
 	ErrNoContentDestination = errors.New("no content was returned by server but destination is not empty")
+	ErrBodyNotReplayable    = errors.New("request body cannot be replayed")
 )
 
 type RequestOptions struct {
@@ -43,44 +45,74 @@ func NewAPIRequest(ctx context.Context, opts RequestOptions, jsonBody bool) (*ht
 		return nil, api.ErrUnknownType
 	}
 
-	if opts.URLValues != nil {
+	if len(opts.URLValues) != 0 {
 		endpointURL = fmt.Sprintf("%s?%s", endpointURL, opts.URLValues.Encode())
 	}
 
-	req, err := http.NewRequestWithContext(ctx, opts.Method, endpointURL, nil)
+	var (
+		body        io.Reader
+		contentType string
+	)
+
+	if opts.Body != nil {
+		if jsonBody {
+			jsonBytes, err := json.Marshal(opts.Body)
+			if err != nil {
+				return nil, fmt.Errorf("marshal request: %w", err)
+			}
+
+			body = bytes.NewReader(jsonBytes)
+			contentType = "application/json"
+		} else {
+			urlValues, ok := opts.Body.(url.Values)
+			if !ok {
+				return nil, ErrUnknownBody
+			}
+
+			body = strings.NewReader(urlValues.Encode())
+			contentType = "application/x-www-form-urlencoded"
+		}
+	}
+
+	// body is passed as one of the standard readers, so the request gets GetBody
+	// populated and can be replayed with CloneRequest.
+	req, err := http.NewRequestWithContext(ctx, opts.Method, endpointURL, body)
 	if err != nil {
 		return nil, fmt.Errorf("create request: %w", err)
 	}
 
-	if opts.Body == nil {
-		return req, nil
+	if len(contentType) != 0 {
+		req.Header.Set("Content-Type", contentType)
 	}
 
-	var body io.Reader
+	return req, nil
+}
 
-	if jsonBody {
-		jsonBytes, err := json.Marshal(opts.Body)
-		if err != nil {
-			return nil, fmt.Errorf("marshal request: %w", err)
-		}
+// CloneRequest returns a deep copy of the request with a fresh body, so the same
+// request can be sent more than once (e.g. on retry).
+func CloneRequest(req *http.Request) (*http.Request, error) {
+	clone := req.Clone(req.Context())
 
-		body = bytes.NewBuffer(jsonBytes)
-		req.Header.Set("Content-Type", "application/json")
-	} else {
-		urlValues, ok := opts.Body.(url.Values)
-		if !ok {
-			return nil, ErrUnknownBody
-		}
+	if req.Body == nil || req.Body == http.NoBody {
+		return clone, nil
+	}
 
-		body = bytes.NewBuffer([]byte(urlValues.Encode()))
-		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	if req.GetBody == nil {
+		return nil, ErrBodyNotReplayable
 	}
 
-	req.Body = io.NopCloser(body)
+	body, err := req.GetBody()
+	if err != nil {
+		return nil, fmt.Errorf("get request body: %w", err)
+	}
 
-	return req, nil
+	clone.Body = body
+
+	return clone, nil
 }
 
+// DoAPIRequest sends the request and decodes successful JSON response into dest. If dest
+// is *[]byte, response body is stored into it as is (for non-JSON responses).
 func DoAPIRequest(req *http.Request, dest any, httpClient ...HTTPClient) (api.ResponseMetadata, error) {
 	client := GetOrDefaultHTTPClient(httpClient...)
 
@@ -122,6 +154,11 @@ func DoAPIRequest(req *http.Request, dest any, httpClient ...HTTPClient) (api.Re
 		return metadata, UnsuccessfulRequestError(res.Status)
 	}
 
+	if raw, ok := dest.(*[]byte); ok {
+		*raw = bodyBytes
+		return metadata, nil
+	}
+
 	if dest != nil {
 		if err = json.Unmarshal(bodyBytes, dest); err != nil {
 			return metadata, fmt.Errorf("unmarshal response body: %w", err)
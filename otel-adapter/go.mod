@@ -0,0 +1,13 @@
+module github.com/kvizyx/twitchkit/otel-adapter
+
+go 1.23.0
+
+require (
+	github.com/kvizyx/twitchkit v0.0.0
+	go.opentelemetry.io/otel v1.37.0
+	go.opentelemetry.io/otel/metric v1.37.0
+	go.opentelemetry.io/otel/trace v1.37.0
+)
+
+// twitchkit has no release yet, so the adapter is built against the parent module.
+replace github.com/kvizyx/twitchkit => ../
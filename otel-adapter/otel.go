@@ -0,0 +1,202 @@
+// Package oteladapter adapts OpenTelemetry tracer and meter to the twitchkit
+// telemetry hooks.
+package oteladapter
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/kvizyx/twitchkit/telemetry"
+)
+
+const instrumentationName = "github.com/kvizyx/twitchkit"
+
+// Tracer is telemetry.Tracer backed by OpenTelemetry tracer.
+type Tracer struct {
+	tracer trace.Tracer
+}
+
+var _ telemetry.Tracer = Tracer{}
+
+func NewTracer(provider trace.TracerProvider) Tracer {
+	return Tracer{tracer: provider.Tracer(instrumentationName)}
+}
+
+func (t Tracer) Start(
+	ctx context.Context,
+	name string,
+	attrs ...telemetry.Attribute,
+) (context.Context, telemetry.Span) {
+	ctx, span := t.tracer.Start(ctx, name,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(convertAttributes(attrs)...),
+	)
+
+	return ctx, Span{span: span}
+}
+
+// Span is telemetry.Span backed by OpenTelemetry span.
+type Span struct {
+	span trace.Span
+}
+
+var _ telemetry.Span = Span{}
+
+func (s Span) SetAttributes(attrs ...telemetry.Attribute) {
+	s.span.SetAttributes(convertAttributes(attrs)...)
+}
+
+func (s Span) RecordError(err error) {
+	s.span.RecordError(err)
+	s.span.SetStatus(codes.Error, err.Error())
+}
+
+func (s Span) End() {
+	s.span.End()
+}
+
+// Metrics is telemetry.Metrics backed by OpenTelemetry meter.
+type Metrics struct {
+	requestDuration    metric.Float64Histogram
+	requests           metric.Int64Counter
+	cacheHits          metric.Int64Counter
+	retries            metric.Int64Counter
+	rateLimitRemaining metric.Int64Gauge
+	refreshFailures    metric.Int64Counter
+}
+
+var _ telemetry.Metrics = Metrics{}
+
+func NewMetrics(provider metric.MeterProvider) (Metrics, error) {
+	meter := provider.Meter(instrumentationName)
+
+	requestDuration, err := meter.Float64Histogram("twitchkit.helix.request.duration",
+		metric.WithDescription("Duration of the Helix requests."),
+		metric.WithUnit("s"),
+	)
+	if err != nil {
+		return Metrics{}, fmt.Errorf("create request duration histogram: %w", err)
+	}
+
+	requests, err := meter.Int64Counter("twitchkit.helix.requests",
+		metric.WithDescription("Number of the Helix requests by status code."),
+		metric.WithUnit("{request}"),
+	)
+	if err != nil {
+		return Metrics{}, fmt.Errorf("create requests counter: %w", err)
+	}
+
+	cacheHits, err := meter.Int64Counter("twitchkit.helix.cache.hits",
+		metric.WithDescription("Number of the Helix responses served from the cache."),
+		metric.WithUnit("{hit}"),
+	)
+	if err != nil {
+		return Metrics{}, fmt.Errorf("create cache hits counter: %w", err)
+	}
+
+	retries, err := meter.Int64Counter("twitchkit.helix.retries",
+		metric.WithDescription("Number of the Helix request retries."),
+		metric.WithUnit("{retry}"),
+	)
+	if err != nil {
+		return Metrics{}, fmt.Errorf("create retries counter: %w", err)
+	}
+
+	rateLimitRemaining, err := meter.Int64Gauge("twitchkit.helix.ratelimit.remaining",
+		metric.WithDescription("Points left in the Helix rate-limit bucket."),
+		metric.WithUnit("{point}"),
+	)
+	if err != nil {
+		return Metrics{}, fmt.Errorf("create rate-limit remaining gauge: %w", err)
+	}
+
+	refreshFailures, err := meter.Int64Counter("twitchkit.auth.refresh.failures",
+		metric.WithDescription("Number of the failed user access token refreshes."),
+		metric.WithUnit("{failure}"),
+	)
+	if err != nil {
+		return Metrics{}, fmt.Errorf("create refresh failures counter: %w", err)
+	}
+
+	return Metrics{
+		requestDuration:    requestDuration,
+		requests:           requests,
+		cacheHits:          cacheHits,
+		retries:            retries,
+		rateLimitRemaining: rateLimitRemaining,
+		refreshFailures:    refreshFailures,
+	}, nil
+}
+
+func (m Metrics) RecordRequest(ctx context.Context, record telemetry.RequestRecord) {
+	attrs := metric.WithAttributes(
+		attribute.String("twitch.endpoint", record.Endpoint),
+		attribute.String("http.method", record.Method),
+		attribute.String("http.status_code", statusCode(record.StatusCode)),
+	)
+
+	m.requestDuration.Record(ctx, record.Duration.Seconds(), attrs)
+	m.requests.Add(ctx, 1, attrs)
+}
+
+func (m Metrics) RecordCacheHit(ctx context.Context, endpoint string) {
+	m.cacheHits.Add(ctx, 1, metric.WithAttributes(attribute.String("twitch.endpoint", endpoint)))
+}
+
+func (m Metrics) RecordRetry(ctx context.Context, endpoint string, reason telemetry.RetryReason) {
+	m.retries.Add(ctx, 1, metric.WithAttributes(
+		attribute.String("twitch.endpoint", endpoint),
+		attribute.String("twitch.retry_reason", string(reason)),
+	))
+}
+
+func (m Metrics) RecordRateLimitRemaining(ctx context.Context, remaining int) {
+	m.rateLimitRemaining.Record(ctx, int64(remaining))
+}
+
+func (m Metrics) RecordRefresh(ctx context.Context, err error) {
+	if err == nil {
+		return
+	}
+
+	m.refreshFailures.Add(ctx, 1)
+}
+
+// statusCode returns status code as attribute value, "error" is used for requests
+// that did not receive any response.
+func statusCode(code int) string {
+	if code == 0 {
+		return "error"
+	}
+
+	return strconv.Itoa(code)
+}
+
+func convertAttributes(attrs []telemetry.Attribute) []attribute.KeyValue {
+	converted := make([]attribute.KeyValue, 0, len(attrs))
+
+	for _, attr := range attrs {
+		switch value := attr.Value.(type) {
+		case string:
+			converted = append(converted, attribute.String(attr.Key, value))
+		case int:
+			converted = append(converted, attribute.Int(attr.Key, value))
+		case int64:
+			converted = append(converted, attribute.Int64(attr.Key, value))
+		case bool:
+			converted = append(converted, attribute.Bool(attr.Key, value))
+		case float64:
+			converted = append(converted, attribute.Float64(attr.Key, value))
+		default:
+			converted = append(converted, attribute.String(attr.Key, fmt.Sprint(value)))
+		}
+	}
+
+	return converted
+}
@@ -0,0 +1,304 @@
+package oteladapter
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	metricnoop "go.opentelemetry.io/otel/metric/noop"
+	"go.opentelemetry.io/otel/trace"
+	tracenoop "go.opentelemetry.io/otel/trace/noop"
+
+	"github.com/kvizyx/twitchkit/telemetry"
+)
+
+// recordingSpan records calls made by Span.
+type recordingSpan struct {
+	tracenoop.Span
+
+	name   string
+	kind   trace.SpanKind
+	attrs  []attribute.KeyValue
+	errs   []error
+	status codes.Code
+	ended  bool
+}
+
+func (s *recordingSpan) SetAttributes(attrs ...attribute.KeyValue) {
+	s.attrs = append(s.attrs, attrs...)
+}
+
+func (s *recordingSpan) RecordError(err error, _ ...trace.EventOption) {
+	s.errs = append(s.errs, err)
+}
+
+func (s *recordingSpan) SetStatus(code codes.Code, _ string) {
+	s.status = code
+}
+
+func (s *recordingSpan) End(...trace.SpanEndOption) {
+	s.ended = true
+}
+
+type recordingTracer struct {
+	tracenoop.Tracer
+	spans []*recordingSpan
+}
+
+func (t *recordingTracer) Start(
+	ctx context.Context,
+	name string,
+	opts ...trace.SpanStartOption,
+) (context.Context, trace.Span) {
+	cfg := trace.NewSpanStartConfig(opts...)
+
+	span := &recordingSpan{name: name, kind: cfg.SpanKind(), attrs: cfg.Attributes()}
+	t.spans = append(t.spans, span)
+
+	return trace.ContextWithSpan(ctx, span), span
+}
+
+type recordingTracerProvider struct {
+	tracenoop.TracerProvider
+	tracer *recordingTracer
+}
+
+func (p recordingTracerProvider) Tracer(string, ...trace.TracerOption) trace.Tracer {
+	return p.tracer
+}
+
+// measurement is a value recorded by one of the instruments.
+type measurement struct {
+	value float64
+	attrs attribute.Set
+}
+
+// recordingMeter records measurements of the instruments by their names.
+type recordingMeter struct {
+	metricnoop.Meter
+
+	measurements map[string][]measurement
+	locker       sync.Mutex
+}
+
+func (m *recordingMeter) record(name string, value float64, attrs attribute.Set) {
+	m.locker.Lock()
+	defer m.locker.Unlock()
+
+	m.measurements[name] = append(m.measurements[name], measurement{value: value, attrs: attrs})
+}
+
+func (m *recordingMeter) get(name string) []measurement {
+	m.locker.Lock()
+	defer m.locker.Unlock()
+
+	return m.measurements[name]
+}
+
+func (m *recordingMeter) Int64Counter(name string, _ ...metric.Int64CounterOption) (metric.Int64Counter, error) {
+	return int64Counter{meter: m, name: name}, nil
+}
+
+func (m *recordingMeter) Int64Gauge(name string, _ ...metric.Int64GaugeOption) (metric.Int64Gauge, error) {
+	return int64Gauge{meter: m, name: name}, nil
+}
+
+func (m *recordingMeter) Float64Histogram(
+	name string,
+	_ ...metric.Float64HistogramOption,
+) (metric.Float64Histogram, error) {
+	return float64Histogram{meter: m, name: name}, nil
+}
+
+type int64Counter struct {
+	metricnoop.Int64Counter
+	meter *recordingMeter
+	name  string
+}
+
+func (c int64Counter) Add(_ context.Context, value int64, opts ...metric.AddOption) {
+	c.meter.record(c.name, float64(value), metric.NewAddConfig(opts).Attributes())
+}
+
+type int64Gauge struct {
+	metricnoop.Int64Gauge
+	meter *recordingMeter
+	name  string
+}
+
+func (g int64Gauge) Record(_ context.Context, value int64, opts ...metric.RecordOption) {
+	g.meter.record(g.name, float64(value), metric.NewRecordConfig(opts).Attributes())
+}
+
+type float64Histogram struct {
+	metricnoop.Float64Histogram
+	meter *recordingMeter
+	name  string
+}
+
+func (h float64Histogram) Record(_ context.Context, value float64, opts ...metric.RecordOption) {
+	h.meter.record(h.name, value, metric.NewRecordConfig(opts).Attributes())
+}
+
+type recordingMeterProvider struct {
+	metricnoop.MeterProvider
+	meter *recordingMeter
+}
+
+func (p recordingMeterProvider) Meter(string, ...metric.MeterOption) metric.Meter {
+	return p.meter
+}
+
+func newTestMetrics(t *testing.T) (Metrics, *recordingMeter) {
+	t.Helper()
+
+	meter := &recordingMeter{measurements: make(map[string][]measurement)}
+
+	metrics, err := NewMetrics(recordingMeterProvider{meter: meter})
+	if err != nil {
+		t.Fatalf("new metrics: %v", err)
+	}
+
+	return metrics, meter
+}
+
+func attributeValue(set attribute.Set, key string) string {
+	value, _ := set.Value(attribute.Key(key))
+	return value.Emit()
+}
+
+func TestTracer(t *testing.T) {
+	recorder := &recordingTracer{}
+	tracer := NewTracer(recordingTracerProvider{tracer: recorder})
+
+	ctx, span := tracer.Start(context.Background(), "helix.request",
+		telemetry.String("twitch.endpoint", "users"),
+		telemetry.Int("http.status_code", 200),
+		telemetry.Attribute{Key: "retry", Value: true},
+		telemetry.Attribute{Key: "duration", Value: time.Second},
+	)
+
+	span.SetAttributes(telemetry.Attribute{Key: "points", Value: int64(800)})
+	span.RecordError(errors.New("unavailable"))
+	span.End()
+
+	if len(recorder.spans) != 1 {
+		t.Fatalf("started %d spans, want 1", len(recorder.spans))
+	}
+
+	started := recorder.spans[0]
+
+	if trace.SpanFromContext(ctx) != trace.Span(started) {
+		t.Error("span is not put into the context")
+	}
+
+	if started.name != "helix.request" || started.kind != trace.SpanKindClient {
+		t.Errorf("span name = %q, kind = %v", started.name, started.kind)
+	}
+
+	want := []attribute.KeyValue{
+		attribute.String("twitch.endpoint", "users"),
+		attribute.Int("http.status_code", 200),
+		attribute.Bool("retry", true),
+		attribute.String("duration", "1s"),
+		attribute.Int64("points", 800),
+	}
+
+	if len(started.attrs) != len(want) {
+		t.Fatalf("attributes = %v, want %v", started.attrs, want)
+	}
+
+	for i := range want {
+		if started.attrs[i] != want[i] {
+			t.Errorf("attribute %d = %v, want %v", i, started.attrs[i], want[i])
+		}
+	}
+
+	if len(started.errs) != 1 || started.status != codes.Error {
+		t.Errorf("errors = %v, status = %v", started.errs, started.status)
+	}
+
+	if !started.ended {
+		t.Error("span is not ended")
+	}
+}
+
+func TestMetricsRecordRequest(t *testing.T) {
+	metrics, meter := newTestMetrics(t)
+
+	metrics.RecordRequest(context.Background(), telemetry.RequestRecord{
+		Endpoint:   "users",
+		Method:     "GET",
+		StatusCode: 200,
+		Duration:   1500 * time.Millisecond,
+	})
+
+	metrics.RecordRequest(context.Background(), telemetry.RequestRecord{
+		Endpoint: "users",
+		Method:   "GET",
+		Err:      errors.New("connection refused"),
+	})
+
+	durations := meter.get("twitchkit.helix.request.duration")
+	if len(durations) != 2 || durations[0].value != 1.5 {
+		t.Fatalf("durations = %v", durations)
+	}
+
+	requests := meter.get("twitchkit.helix.requests")
+	if len(requests) != 2 {
+		t.Fatalf("requests = %v", requests)
+	}
+
+	for i, wantStatus := range []string{"200", "error"} {
+		attrs := requests[i].attrs
+
+		if got := attributeValue(attrs, "http.status_code"); got != wantStatus {
+			t.Errorf("request %d: status code = %q, want %q", i, got, wantStatus)
+		}
+
+		if got := attributeValue(attrs, "twitch.endpoint"); got != "users" {
+			t.Errorf("request %d: endpoint = %q, want %q", i, got, "users")
+		}
+
+		if got := attributeValue(attrs, "http.method"); got != "GET" {
+			t.Errorf("request %d: method = %q, want %q", i, got, "GET")
+		}
+	}
+}
+
+func TestMetricsRecordOthers(t *testing.T) {
+	metrics, meter := newTestMetrics(t)
+
+	ctx := context.Background()
+
+	metrics.RecordCacheHit(ctx, "chat/badges/global")
+	metrics.RecordRetry(ctx, "users", telemetry.RetryReasonRateLimit)
+	metrics.RecordRateLimitRemaining(ctx, 799)
+	metrics.RecordRefresh(ctx, nil)
+	metrics.RecordRefresh(ctx, errors.New("invalid refresh token"))
+
+	hits := meter.get("twitchkit.helix.cache.hits")
+	if len(hits) != 1 || attributeValue(hits[0].attrs, "twitch.endpoint") != "chat/badges/global" {
+		t.Errorf("cache hits = %v", hits)
+	}
+
+	retries := meter.get("twitchkit.helix.retries")
+	if len(retries) != 1 || attributeValue(retries[0].attrs, "twitch.retry_reason") != "rate_limit" {
+		t.Errorf("retries = %v", retries)
+	}
+
+	remaining := meter.get("twitchkit.helix.ratelimit.remaining")
+	if len(remaining) != 1 || remaining[0].value != 799 {
+		t.Errorf("rate-limit remaining = %v", remaining)
+	}
+
+	// only failed refreshes are counted.
+	if failures := meter.get("twitchkit.auth.refresh.failures"); len(failures) != 1 {
+		t.Errorf("refresh failures = %v", failures)
+	}
+}
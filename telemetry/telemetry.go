@@ -0,0 +1,127 @@
+// Package telemetry describes vendor-neutral tracing and metrics hooks used across
+// twitchkit. Implementations for specific vendors (e.g. OpenTelemetry) live in
+// separate modules, so twitchkit itself does not depend on any of them.
+package telemetry
+
+import (
+	"context"
+	"time"
+)
+
+// RetryReason is a reason why request was retried.
+type RetryReason string
+
+const (
+	RetryReasonUnauthorized RetryReason = "unauthorized"
+	RetryReasonRateLimit    RetryReason = "rate_limit"
+	RetryReasonUnavailable  RetryReason = "unavailable"
+)
+
+// Attribute is a key-value pair attached to the span.
+type Attribute struct {
+	Key   string
+	Value any
+}
+
+func String(key, value string) Attribute {
+	return Attribute{Key: key, Value: value}
+}
+
+func Int(key string, value int) Attribute {
+	return Attribute{Key: key, Value: value}
+}
+
+// Tracer starts spans for traced operations.
+type Tracer interface {
+	// Start starts a new span as a child of span in the given context (if any) and
+	// returns context containing the new span.
+	Start(ctx context.Context, name string, attrs ...Attribute) (context.Context, Span)
+}
+
+// Span is a single traced operation.
+type Span interface {
+	SetAttributes(attrs ...Attribute)
+	RecordError(err error)
+	End()
+}
+
+// RequestRecord describes single HTTP request attempt made to the Twitch API.
+type RequestRecord struct {
+	// Endpoint is a resource of the Twitch API without base URL (e.g. "chat/badges/global").
+	Endpoint string
+	Method   string
+
+	// StatusCode is zero if request failed before response was received.
+	StatusCode int
+	Duration   time.Duration
+	Err        error
+}
+
+// Metrics records measurements of the twitchkit operations.
+type Metrics interface {
+	// RecordRequest records single request attempt, so it includes retries. Responses
+	// served from the cache are not requests and are recorded by RecordCacheHit instead.
+	RecordRequest(ctx context.Context, record RequestRecord)
+
+	// RecordCacheHit records that response of the endpoint was served from the cache
+	// without request to the Twitch API.
+	RecordCacheHit(ctx context.Context, endpoint string)
+
+	// RecordRetry records that request to the endpoint is going to be retried.
+	RecordRetry(ctx context.Context, endpoint string, reason RetryReason)
+
+	// RecordRateLimitRemaining records the last known number of points left in the
+	// rate-limit bucket.
+	RecordRateLimitRemaining(ctx context.Context, remaining int)
+
+	// RecordRefresh records user access token refresh, err is nil if refresh succeed.
+	RecordRefresh(ctx context.Context, err error)
+}
+
+// Nop is Tracer, Span and Metrics that does nothing. It's used in place of the
+// unset hooks.
+type Nop struct{}
+
+var (
+	_ Tracer  = Nop{}
+	_ Span    = Nop{}
+	_ Metrics = Nop{}
+)
+
+func (Nop) Start(ctx context.Context, _ string, _ ...Attribute) (context.Context, Span) {
+	return ctx, Nop{}
+}
+
+func (Nop) SetAttributes(...Attribute) {}
+
+func (Nop) RecordError(error) {}
+
+func (Nop) End() {}
+
+func (Nop) RecordRequest(context.Context, RequestRecord) {}
+
+func (Nop) RecordCacheHit(context.Context, string) {}
+
+func (Nop) RecordRetry(context.Context, string, RetryReason) {}
+
+func (Nop) RecordRateLimitRemaining(context.Context, int) {}
+
+func (Nop) RecordRefresh(context.Context, error) {}
+
+// TracerOrNop returns given tracer or Nop if it's nil.
+func TracerOrNop(tracer Tracer) Tracer {
+	if tracer == nil {
+		return Nop{}
+	}
+
+	return tracer
+}
+
+// MetricsOrNop returns given metrics or Nop if it's nil.
+func MetricsOrNop(metrics Metrics) Metrics {
+	if metrics == nil {
+		return Nop{}
+	}
+
+	return metrics
+}
@@ -0,0 +1,55 @@
+package telemetry
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+type recordingMetrics struct {
+	Nop
+	requests int
+}
+
+func (m *recordingMetrics) RecordRequest(context.Context, RequestRecord) {
+	m.requests++
+}
+
+func TestNopDefaults(t *testing.T) {
+	if _, ok := TracerOrNop(nil).(Nop); !ok {
+		t.Error("TracerOrNop(nil) is not Nop")
+	}
+
+	if _, ok := MetricsOrNop(nil).(Nop); !ok {
+		t.Error("MetricsOrNop(nil) is not Nop")
+	}
+
+	metrics := &recordingMetrics{}
+	MetricsOrNop(metrics).RecordRequest(context.Background(), RequestRecord{})
+
+	if metrics.requests != 1 {
+		t.Errorf("given metrics recorded %d requests, want 1", metrics.requests)
+	}
+}
+
+func TestNopTracer(t *testing.T) {
+	type ctxKey struct{}
+
+	ctx := context.WithValue(context.Background(), ctxKey{}, "value")
+
+	spanCtx, span := Nop{}.Start(ctx, "span", String("key", "value"), Int("count", 1))
+	if spanCtx != ctx {
+		t.Error("Nop tracer changed the context")
+	}
+
+	// none of them should panic.
+	span.SetAttributes(String("key", "value"))
+	span.RecordError(errors.New("error"))
+	span.End()
+
+	metrics := MetricsOrNop(nil)
+	metrics.RecordRequest(ctx, RequestRecord{})
+	metrics.RecordRetry(ctx, "users", RetryReasonUnavailable)
+	metrics.RecordRateLimitRemaining(ctx, 800)
+	metrics.RecordRefresh(ctx, nil)
+}